@@ -0,0 +1,4 @@
+# List all metric integrations of the status page with subdomain "example-com".
+data "statuspal_metric_integrations" "all" {
+  status_page_subdomain = "example-com"
+}
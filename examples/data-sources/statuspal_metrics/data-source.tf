@@ -2,3 +2,13 @@
 data "statuspal_metrics" "example" {
   status_page_subdomain = "example-com"
 }
+
+# List the enabled response time metrics of the status page.
+data "statuspal_metrics" "response_times" {
+  status_page_subdomain = "example-com"
+
+  filter {
+    type    = "rt"
+    enabled = true
+  }
+}
@@ -0,0 +1,4 @@
+# List all notification recipients of the status page with subdomain "example-com".
+data "statuspal_notification_recipients" "all" {
+  status_page_subdomain = "example-com"
+}
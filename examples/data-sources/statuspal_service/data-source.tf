@@ -0,0 +1,14 @@
+# Look up a service of the status page with subdomain "example-com" by its ID.
+data "statuspal_service" "database" {
+  status_page_subdomain = "example-com"
+  service_id            = "1"
+}
+
+# Look up the "API" service under the "Backend" parent service, failing when
+# several services match.
+data "statuspal_service" "backend_api" {
+  status_page_subdomain = "example-com"
+  name                  = "API"
+  parent_name           = "Backend"
+  require_unique        = true
+}
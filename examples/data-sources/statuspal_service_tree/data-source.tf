@@ -0,0 +1,14 @@
+# Resolve the services of the status page with subdomain "example-com" as a
+# tree, failing when their parent and children links are inconsistent.
+data "statuspal_service_tree" "example" {
+  status_page_subdomain = "example-com"
+  strict                = true
+}
+
+# The full path of the services which are down, or have a descendant down.
+output "degraded_services" {
+  value = [
+    for service in data.statuspal_service_tree.example.services : service.path
+    if !service.aggregated_is_up
+  ]
+}
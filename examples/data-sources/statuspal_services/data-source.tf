@@ -2,3 +2,22 @@
 data "statuspal_services" "all" {
   status_page_subdomain = "example-com"
 }
+
+# List the services of the status page which are down.
+data "statuspal_services" "down" {
+  status_page_subdomain = "example-com"
+
+  filter {
+    is_up = false
+  }
+}
+
+# List the private top-level services of the status page.
+data "statuspal_services" "private" {
+  status_page_subdomain = "example-com"
+
+  filter {
+    private   = true
+    parent_id = ""
+  }
+}
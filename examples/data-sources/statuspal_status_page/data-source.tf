@@ -0,0 +1,21 @@
+# Look up a status page of the organization with ID 1 by its subdomain.
+data "statuspal_status_page" "example" {
+  organization_id = "1"
+  subdomain       = "example-com"
+}
+
+# Look up a status page by its exact name.
+data "statuspal_status_page" "acme" {
+  organization_id = "1"
+  name            = "Acme"
+}
+
+# Look up a status page by a regular expression matched against its name.
+data "statuspal_status_page" "staging" {
+  organization_id = "1"
+  name_regex      = "(?i)staging"
+}
+
+output "custom_domain_status" {
+  value = data.statuspal_status_page.example.status_page.domain_config.status
+}
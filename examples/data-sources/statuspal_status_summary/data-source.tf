@@ -0,0 +1,14 @@
+# Fetch the current status of the status page with subdomain "example-com".
+data "statuspal_status_summary" "example" {
+  status_page_subdomain = "example-com"
+}
+
+# Refuse to deploy while a major incident is ongoing on the status page.
+resource "terraform_data" "deployment" {
+  lifecycle {
+    precondition {
+      condition     = data.statuspal_status_summary.example.status != "major"
+      error_message = "A major incident is ongoing on the status page."
+    }
+  }
+}
@@ -0,0 +1,4 @@
+# List all members of the organization with ID "1", e.g. for an access review.
+data "statuspal_team_members" "all" {
+  organization_id = "1"
+}
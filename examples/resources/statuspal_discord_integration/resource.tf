@@ -0,0 +1,16 @@
+# Post the updates of the status page with subdomain "example-com" to a Discord channel,
+# its `discord_notifications_enabled` attribute must be enabled.
+variable "discord_webhook_url" {
+  type      = string
+  sensitive = true
+  # e.g. "https://discord.com/api/webhooks/000000000000000000/XXXXXXXX"
+}
+
+resource "statuspal_discord_integration" "example" {
+  organization_id       = "1"
+  status_page_subdomain = "example-com"
+  integration = {
+    webhook_url = var.discord_webhook_url
+    channel     = "#status"
+  }
+}
@@ -0,0 +1,16 @@
+# Post the updates of the status page with subdomain "example-com" to a Google Chat channel,
+# its `google_chat_notifications_enabled` attribute must be enabled.
+variable "google_chat_webhook_url" {
+  type      = string
+  sensitive = true
+  # e.g. "https://chat.googleapis.com/v1/spaces/XXXXXXXX/messages?key=XXXXXXXX"
+}
+
+resource "statuspal_google_chat_integration" "example" {
+  organization_id       = "1"
+  status_page_subdomain = "example-com"
+  integration = {
+    webhook_url = var.google_chat_webhook_url
+    channel     = "Status"
+  }
+}
@@ -0,0 +1,2 @@
+# Incident can be imported by specifying the status page subdomain and incident ID.
+terraform import statuspal_incident.example "example-com 1"
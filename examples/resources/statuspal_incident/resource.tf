@@ -0,0 +1,18 @@
+# Manage example incident of the status page with subdomain "example-com".
+resource "statuspal_incident" "example" {
+  status_page_subdomain = "example-com"
+  close_on_destroy      = true
+  incident = {
+    title       = "API outage"
+    type        = "major"
+    message     = "We are investigating the issue."
+    service_ids = [statuspal_service.example.service.id]
+    notify      = true
+    translations = {
+      fr = {
+        title   = "Panne de l'API"
+        message = "Nous enquêtons sur le problème."
+      }
+    }
+  }
+}
@@ -0,0 +1,2 @@
+# Incident type can be imported by specifying the status page subdomain and incident type ID.
+terraform import statuspal_incident_type.example "example-com 1"
@@ -0,0 +1,15 @@
+# Manage a custom incident type of the status page with subdomain "example-com",
+# its `custom_incident_types_enabled` attribute must be enabled.
+resource "statuspal_incident_type" "example" {
+  status_page_subdomain = "example-com"
+  incident_type = {
+    name     = "Degraded performance"
+    color    = "FFA500"
+    severity = "minor"
+    translations = {
+      fr = {
+        name = "Dégradation des performances"
+      }
+    }
+  }
+}
@@ -0,0 +1,2 @@
+# Incident update can be imported by specifying the status page subdomain, incident ID and incident update ID.
+terraform import statuspal_incident_update.example "example-com 1 11"
@@ -0,0 +1,27 @@
+# Post the updates of the example incident of the status page with subdomain "example-com".
+resource "statuspal_incident_update" "identified" {
+  status_page_subdomain = "example-com"
+  incident_id           = statuspal_incident.example.incident.id
+  incident_update = {
+    state   = "identified"
+    message = "We identified the issue, a fix is being deployed."
+    notify  = true
+    translations = {
+      fr = {
+        message = "Nous avons identifié le problème, un correctif est en cours de déploiement."
+      }
+    }
+  }
+}
+
+resource "statuspal_incident_update" "resolved" {
+  status_page_subdomain = "example-com"
+  incident_id           = statuspal_incident.example.incident.id
+  incident_update = {
+    state   = "resolved"
+    message = "The issue is resolved."
+    notify  = true
+  }
+
+  depends_on = [statuspal_incident_update.identified]
+}
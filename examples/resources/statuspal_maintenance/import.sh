@@ -0,0 +1,2 @@
+# Maintenance can be imported by specifying the status page subdomain and maintenance ID.
+terraform import statuspal_maintenance.example "example-com 1"
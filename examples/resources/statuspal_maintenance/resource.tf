@@ -0,0 +1,18 @@
+# Manage example maintenance of the status page with subdomain "example-com".
+resource "statuspal_maintenance" "example" {
+  status_page_subdomain = "example-com"
+  maintenance = {
+    title       = "Database upgrade"
+    description = "We are upgrading our database, the API may be slower."
+    service_ids = [statuspal_service.example.service.id]
+    starts_at   = "2024-05-16T22:00:00Z"
+    ends_at     = "2024-05-17T02:00:00Z"
+    notify      = true
+    translations = {
+      fr = {
+        title       = "Mise à jour de la base de données"
+        description = "Nous mettons à jour notre base de données, l'API peut être plus lente."
+      }
+    }
+  }
+}
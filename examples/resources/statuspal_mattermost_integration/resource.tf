@@ -0,0 +1,16 @@
+# Post the updates of the status page with subdomain "example-com" to a Mattermost channel,
+# its `mattermost_notifications_enabled` attribute must be enabled.
+variable "mattermost_webhook_url" {
+  type      = string
+  sensitive = true
+  # e.g. "https://mattermost.example.com/hooks/XXXXXXXX"
+}
+
+resource "statuspal_mattermost_integration" "example" {
+  organization_id       = "1"
+  status_page_subdomain = "example-com"
+  integration = {
+    webhook_url = var.mattermost_webhook_url
+    channel     = "status"
+  }
+}
@@ -0,0 +1,9 @@
+# Submit the response times measured by a synthetic check to a metric of the status page with subdomain "example-com".
+resource "statuspal_metric_entry" "synthetic_check" {
+  status_page_subdomain = "example-com"
+  metric_id             = statuspal_metric.response_time.metric.id
+  entries = [
+    { timestamp = "2024-05-16T10:00:00Z", value = 120 },
+    { timestamp = "2024-05-16T10:01:00Z", value = 95.5 },
+  ]
+}
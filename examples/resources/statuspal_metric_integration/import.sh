@@ -0,0 +1,2 @@
+# Metric integration can be imported by specifying the status page subdomain and metric integration ID.
+terraform import statuspal_metric_integration.example "example-com 1"
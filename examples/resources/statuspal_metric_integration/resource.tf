@@ -0,0 +1,36 @@
+# Pull the metrics of the status page with subdomain "example-com" from Datadog.
+variable "datadog_api_key" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+
+variable "datadog_application_key" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+
+resource "statuspal_metric_integration" "datadog" {
+  status_page_subdomain = "example-com"
+  metric_integration = {
+    type = "datadog"
+    name = "Datadog"
+    credentials = {
+      api_key         = var.datadog_api_key
+      application_key = var.datadog_application_key
+    }
+    credentials_version = 1 # Increment it to send rotated credentials
+  }
+}
+
+resource "statuspal_metric" "api_latency" {
+  status_page_subdomain = "example-com"
+  metric = {
+    title          = "API latency"
+    unit           = "ms"
+    type           = "rt"
+    remote_id      = "avg:trace.http.request.duration{service:api}"
+    integration_id = tonumber(statuspal_metric_integration.datadog.metric_integration.id)
+  }
+}
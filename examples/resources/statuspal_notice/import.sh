@@ -0,0 +1,2 @@
+# Notice can be imported by specifying the status page subdomain and notice ID.
+terraform import statuspal_notice.example "example-com 1"
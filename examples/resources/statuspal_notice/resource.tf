@@ -0,0 +1,15 @@
+# Schedule a notice on the status page with subdomain "example-com",
+# its `info_notices_enabled` attribute must be enabled.
+resource "statuspal_notice" "holiday_support" {
+  status_page_subdomain = "example-com"
+  notice = {
+    message   = "Our support team is available from 9am to 5pm CET during the holidays."
+    starts_at = "2024-12-23T00:00:00Z"
+    ends_at   = "2025-01-02T00:00:00Z"
+    translations = {
+      fr = {
+        message = "Notre équipe support est disponible de 9h à 17h CET pendant les fêtes."
+      }
+    }
+  }
+}
@@ -0,0 +1,2 @@
+# Notification recipient can be imported by specifying the status page subdomain and notification recipient ID.
+terraform import statuspal_notification_recipient.example "example-com 1"
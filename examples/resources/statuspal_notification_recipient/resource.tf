@@ -0,0 +1,14 @@
+# Manage the recipients of the monitoring alerts of the status page with subdomain "example-com".
+variable "oncall_team" {
+  type    = set(string)
+  default = ["alice@example.com", "bob@example.com"]
+}
+
+resource "statuspal_notification_recipient" "oncall" {
+  for_each = var.oncall_team
+
+  status_page_subdomain = "example-com"
+  notification_recipient = {
+    email = each.value
+  }
+}
@@ -0,0 +1,16 @@
+# Post the updates of the status page with subdomain "example-com" to a Slack channel,
+# its `slack_subscriptions_enabled` attribute must be enabled.
+variable "slack_webhook_url" {
+  type      = string
+  sensitive = true
+  # e.g. "https://hooks.slack.com/services/T00000000/B00000000/XXXXXXXX"
+}
+
+resource "statuspal_slack_integration" "example" {
+  organization_id       = "1"
+  status_page_subdomain = "example-com"
+  integration = {
+    webhook_url = var.slack_webhook_url
+    channel     = "#status"
+  }
+}
@@ -0,0 +1,2 @@
+# Subscriber can be imported by specifying the status page subdomain and subscriber ID.
+terraform import statuspal_subscriber.example "example-com 1"
@@ -0,0 +1,9 @@
+# Manage example subscriber of the status page with subdomain "example-com".
+resource "statuspal_subscriber" "example" {
+  status_page_subdomain = "example-com"
+  subscriber = {
+    channel     = "email"
+    address     = "oncall@example.com"
+    service_ids = [statuspal_service.example.service.id]
+  }
+}
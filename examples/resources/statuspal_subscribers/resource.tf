@@ -0,0 +1,20 @@
+# Manage the internal stakeholders subscribed to the status page with subdomain "example-com".
+locals {
+  stakeholders = ["ceo@example.com", "support@example.com", "sales@example.com"]
+}
+
+resource "statuspal_subscribers" "example" {
+  status_page_subdomain = "example-com"
+  subscribers = concat(
+    [for email in local.stakeholders : {
+      channel     = "email"
+      address     = email
+      service_ids = null
+    }],
+    [{
+      channel     = "webhook"
+      address     = "https://hooks.example.com/statuspal"
+      service_ids = [statuspal_service.example.service.id]
+    }],
+  )
+}
@@ -0,0 +1,2 @@
+# Team member can be imported by specifying the organization ID and team member ID.
+terraform import statuspal_team_member.example "1 1"
@@ -0,0 +1,18 @@
+# Manage the team of the organization with ID "1".
+variable "team" {
+  type = map(string)
+  default = {
+    "alice@example.com" = "admin"
+    "bob@example.com"   = "member"
+  }
+}
+
+resource "statuspal_team_member" "example" {
+  for_each = var.team
+
+  organization_id = "1"
+  team_member = {
+    email = each.key
+    role  = each.value
+  }
+}
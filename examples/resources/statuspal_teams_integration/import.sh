@@ -0,0 +1,2 @@
+# Microsoft Teams integration can be imported by specifying the organization ID, the status page subdomain and the integration ID.
+terraform import statuspal_teams_integration.example "1 example-com 1"
@@ -0,0 +1,16 @@
+# Post the updates of the status page with subdomain "example-com" to a Microsoft Teams channel,
+# its `teams_notifications_enabled` attribute must be enabled.
+variable "teams_webhook_url" {
+  type      = string
+  sensitive = true
+  # e.g. "https://example.webhook.office.com/webhookb2/XXXXXXXX"
+}
+
+resource "statuspal_teams_integration" "example" {
+  organization_id       = "1"
+  status_page_subdomain = "example-com"
+  integration = {
+    webhook_url = var.teams_webhook_url
+    channel     = "Status"
+  }
+}
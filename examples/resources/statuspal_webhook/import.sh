@@ -0,0 +1,2 @@
+# Webhook can be imported by specifying the status page subdomain and webhook ID.
+terraform import statuspal_webhook.example "example-com 1"
@@ -0,0 +1,17 @@
+# Post the incidents of the "API" service of the status page with subdomain "example-com" to a URL.
+variable "webhook_secret" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+
+resource "statuspal_webhook" "example" {
+  status_page_subdomain = "example-com"
+  webhook = {
+    url            = "https://example.com/hooks/statuspal"
+    secret         = var.webhook_secret
+    secret_version = 1 # Increment it to send a rotated secret
+    events         = ["incident.created", "incident.updated", "incident.resolved"]
+    service_ids    = [statuspal_service.api.service.id]
+  }
+}
@@ -0,0 +1,107 @@
+package statuspal
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+type ChatIntegrationResponse struct {
+	ChatIntegration ChatIntegration `json:"chat_integration"`
+}
+
+// GetChatIntegration - Returns specific chat integration from the status page.
+func (c *Client) GetChatIntegration(ctx context.Context, statusPageSubdomain *string, chatIntegrationID *string) (*ChatIntegration, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/status_pages/%s/chat_integrations/%s", c.HostURL, *statusPageSubdomain, *chatIntegrationID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	response := ChatIntegrationResponse{}
+	err = json.Unmarshal(*body, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.ChatIntegration, nil
+}
+
+// CreateChatIntegration - Create new chat integration in the status page.
+func (c *Client) CreateChatIntegration(ctx context.Context, chatIntegration *ChatIntegration, statusPageSubdomain *string) (*ChatIntegration, error) {
+	rb, err := json.Marshal(ChatIntegrationResponse{ChatIntegration: *chatIntegration})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/status_pages/%s/chat_integrations", c.HostURL, *statusPageSubdomain), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	response := ChatIntegrationResponse{}
+	err = json.Unmarshal(*body, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.ChatIntegration, nil
+}
+
+// UpdateChatIntegration - Update a chat integration in the status page.
+func (c *Client) UpdateChatIntegration(ctx context.Context, chatIntegration *ChatIntegration, statusPageSubdomain *string, chatIntegrationID *string) (*ChatIntegration, error) {
+	rb, err := json.Marshal(ChatIntegrationResponse{ChatIntegration: *chatIntegration})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/status_pages/%s/chat_integrations/%s", c.HostURL, *statusPageSubdomain, *chatIntegrationID), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	response := ChatIntegrationResponse{}
+	err = json.Unmarshal(*body, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.ChatIntegration, nil
+}
+
+// DeleteChatIntegration - Delete a chat integration in the status page.
+func (c *Client) DeleteChatIntegration(ctx context.Context, statusPageSubdomain *string, chatIntegrationID *string) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/status_pages/%s/chat_integrations/%s", c.HostURL, *statusPageSubdomain, *chatIntegrationID), nil)
+	if err != nil {
+		return err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	convertedBody := string(*body)
+	if convertedBody != `""` {
+		return errors.New(convertedBody)
+	}
+
+	return nil
+}
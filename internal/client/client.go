@@ -4,9 +4,10 @@ import (
 	"fmt"
 	"io"
 	"net/http"
-	"os"
+	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"golang.org/x/time/rate"
 )
 
@@ -15,42 +16,49 @@ type Client struct {
 	HostURL    string
 	HTTPClient *http.Client
 	ApiKey     string
+	// RetryMax is the maximum number of times a failed request is retried, zero disables retries.
+	RetryMax int
+	// RetryWaitMin and RetryWaitMax bound the exponential backoff between two attempts.
+	RetryWaitMin time.Duration
+	RetryWaitMax time.Duration
+
+	rateLimiter       *rate.Limiter
+	requestsPerSecond rate.Limit
+	burst             int
 }
 
-// RateLimit defines a limit of requests per second.
-const RateLimit = 10
-
-// BurstLimit defines a value of request that can be bursted.
-const BurstLimit = 10
-
-// RateLimiter defines the rate limit with a burst for the requests.
-var RateLimiter = rate.NewLimiter(BurstLimit, BurstLimit)
+const (
+	// DefaultRetryMax defines how many times a request is retried by default.
+	DefaultRetryMax = 4
+	// DefaultRetryWaitMin defines the default minimum wait time between retries.
+	DefaultRetryWaitMin = 1 * time.Second
+	// DefaultRetryWaitMax defines the default maximum wait time between retries.
+	DefaultRetryWaitMax = 30 * time.Second
+)
 
 // NewClient function.
-func NewClient(api_key *string, region *string, test_url *string) (*Client, error) {
-	env := os.Getenv("TF_ENV")
-
+//
+// The API is reached through base_url when it is set, otherwise through the URL of the given region.
+func NewClient(api_key *string, region *string, base_url *string) (*Client, error) {
 	c := Client{
 		HTTPClient: &http.Client{Timeout: 10 * time.Second},
 		// Default StatusPal API URL
-		HostURL: "http://local.statuspal.io:4000/api/v2",
+		HostURL:      "http://local.statuspal.io:4000/api/v2",
+		RetryMax:     DefaultRetryMax,
+		RetryWaitMin: DefaultRetryWaitMin,
+		RetryWaitMax: DefaultRetryWaitMax,
 	}
+	c.SetRateLimit(RateLimit, BurstLimit)
 
-	if *region == "EU" || *region == "US" {
+	if base_url != nil && *base_url != "" {
+		c.HostURL = strings.TrimSuffix(*base_url, "/")
+	} else if *region == "EU" || *region == "US" {
 		topLevelDomain := map[string]string{
 			"EU": "eu",
 			"US": "io",
 		}[*region]
 
 		c.HostURL = fmt.Sprintf("https://statuspal.%s/api/v2", topLevelDomain)
-	} else if env == "TEST" {
-		c.HostURL = *test_url
-	}
-
-	if env != "TEST" {
-		if override := os.Getenv("STATUSPAL_API_URL"); override != "" {
-			c.HostURL = override
-		}
 	}
 
 	// If api_key is not provided, return empty client
@@ -64,30 +72,74 @@ func NewClient(api_key *string, region *string, test_url *string) (*Client, erro
 }
 
 func (c *Client) doRequest(req *http.Request) (*[]byte, error) {
-	if !RateLimiter.Allow() {
-		if err := RateLimiter.Wait(req.Context()); err != nil {
-			return nil, fmt.Errorf("failed to wait the time required by rate limiter: %w", err)
-		}
-	}
+	ctx := c.newLogContext(req.Context())
 
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Authorization", c.ApiKey)
 
-	res, err := c.HTTPClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer res.Body.Close()
+	reqBody := readRequestBody(req)
 
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, err
-	}
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 && req.GetBody != nil {
+			// The previous attempt consumed the body, rewind it before sending it again.
+			rewound, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = rewound
+		}
 
-	if res.StatusCode > http.StatusIMUsed {
-		return nil, NewError(res.StatusCode, body)
-	}
+		if err := c.waitRateLimit(ctx); err != nil {
+			return nil, err
+		}
+
+		var body []byte
+		start := time.Now()
+		res, err := c.HTTPClient.Do(req)
+		if err == nil {
+			c.adaptRateLimit(res.Header)
+
+			body, err = io.ReadAll(res.Body)
+			res.Body.Close()
+		}
+		logRequest(ctx, req, reqBody, res, body, time.Since(start), err)
+
+		if attempt < c.RetryMax && shouldRetry(req, res, err) {
+			wait := c.backoff(attempt, res)
+
+			fields := map[string]any{
+				"method":  req.Method,
+				"url":     req.URL.String(),
+				"attempt": attempt + 1,
+				"wait":    wait.String(),
+			}
+			if err != nil {
+				fields["error"] = err.Error()
+			} else {
+				fields["status"] = res.StatusCode
+			}
+			tflog.SubsystemWarn(ctx, LogSubsystem, "Retrying StatusPal API request", fields)
+
+			timer := time.NewTimer(wait)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return nil, ctx.Err()
+			case <-timer.C:
+			}
+
+			continue
+		}
 
-	return &body, nil
+		if err != nil {
+			return nil, err
+		}
+
+		if res.StatusCode > http.StatusIMUsed {
+			return nil, NewError(res.StatusCode, body)
+		}
+
+		return &body, nil
+	}
 }
@@ -1,11 +1,21 @@
 package statuspal
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	"golang.org/x/time/rate"
 )
 
 func TestClient_doRequest_rate_limit(t *testing.T) {
@@ -19,6 +29,7 @@ func TestClient_doRequest_rate_limit(t *testing.T) {
 		HTTPClient: server.Client(),
 		ApiKey:     "test",
 	}
+	client.SetRateLimit(RateLimit, BurstLimit)
 
 	start := time.Now()
 	var requestsCount uint
@@ -33,12 +44,681 @@ func TestClient_doRequest_rate_limit(t *testing.T) {
 		requestsCount += 1
 	}
 
-	requestsTime := time.Since(start).Round(time.Duration(RateLimiter.Limit()) * time.Second)
+	requestsTime := time.Since(start).Round(time.Duration(client.rateLimiter.Limit()) * time.Second)
 
 	requestPerSecond := requestsCount / uint(requestsTime.Seconds())
-	if requestPerSecond != uint(RateLimiter.Burst()) {
+	if requestPerSecond != uint(client.rateLimiter.Burst()) {
 		t.Fatal("Requests rate doesn't meet the request rate required")
 	}
 
 	t.Logf("All requests executed within the rate limit of %d per second", requestPerSecond)
 }
+
+func TestClient_doRequest_rate_limit_per_client(t *testing.T) {
+	first := &Client{}
+	first.SetRateLimit(1, 1)
+
+	second := &Client{}
+	second.SetRateLimit(RateLimit, BurstLimit)
+
+	if first.rateLimiter == second.rateLimiter {
+		t.Fatal("Clients share the same rate limiter")
+	}
+
+	if first.rateLimiter.Limit() != 1 || second.rateLimiter.Limit() != RateLimit {
+		t.Fatal("Clients don't keep their own rate limit")
+	}
+}
+
+func TestClient_doRequest_rate_limit_headers(t *testing.T) {
+	remaining := "0"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Limit", "100")
+		w.Header().Set("X-RateLimit-Remaining", remaining)
+		w.Header().Set("X-RateLimit-Reset", "2")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := &Client{
+		HostURL:    server.URL,
+		HTTPClient: server.Client(),
+		ApiKey:     "test",
+	}
+	client.SetRateLimit(RateLimit, BurstLimit)
+
+	req, _ := http.NewRequest(http.MethodGet, client.HostURL, nil)
+	if _, err := client.doRequest(req); err != nil {
+		t.Fatalf("Request error: %v", err)
+	}
+
+	if limit := client.rateLimiter.Limit(); limit != rate.Every(2*time.Second) {
+		t.Fatalf("Expected the rate limit to be lowered until the reset, got %v", limit)
+	}
+	if burst := client.rateLimiter.Burst(); burst != 1 {
+		t.Fatalf("Expected the burst to be lowered to 1, got %d", burst)
+	}
+
+	// The configured limit is restored once the API has enough remaining requests again.
+	remaining = "100"
+	// Skip the wait imposed by the previous response.
+	client.rateLimiter.SetLimit(rate.Inf)
+
+	req, _ = http.NewRequest(http.MethodGet, client.HostURL, nil)
+	if _, err := client.doRequest(req); err != nil {
+		t.Fatalf("Request error: %v", err)
+	}
+
+	if limit := client.rateLimiter.Limit(); limit != RateLimit {
+		t.Fatalf("Expected the configured rate limit to be restored, got %v", limit)
+	}
+	if burst := client.rateLimiter.Burst(); burst != BurstLimit {
+		t.Fatalf("Expected the configured burst to be restored, got %d", burst)
+	}
+}
+
+func TestClient_doRequest_retry(t *testing.T) {
+	var attempts int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts += 1
+
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != `{"name":"test"}` {
+			t.Errorf("Unexpected body at attempt %d: %q", attempts, body)
+		}
+
+		if attempts < 3 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := &Client{
+		HostURL:      server.URL,
+		HTTPClient:   server.Client(),
+		ApiKey:       "test",
+		RetryMax:     3,
+		RetryWaitMin: time.Millisecond,
+		RetryWaitMax: 10 * time.Millisecond,
+	}
+
+	req, _ := http.NewRequest(http.MethodPut, client.HostURL, strings.NewReader(`{"name":"test"}`))
+	if _, err := client.doRequest(req); err != nil {
+		t.Fatalf("Request error: %v", err)
+	}
+
+	if attempts != 3 {
+		t.Fatalf("Expected 3 attempts, got %d", attempts)
+	}
+}
+
+func TestClient_doRequest_retry_non_idempotent(t *testing.T) {
+	var attempts int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts += 1
+
+		// A POST request is only retried when the server didn't process it.
+		if attempts == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	client := &Client{
+		HostURL:      server.URL,
+		HTTPClient:   server.Client(),
+		ApiKey:       "test",
+		RetryMax:     3,
+		RetryWaitMin: time.Millisecond,
+		RetryWaitMax: 10 * time.Millisecond,
+	}
+
+	req, _ := http.NewRequest(http.MethodPost, client.HostURL, strings.NewReader(`{}`))
+	_, err := client.doRequest(req)
+	if !ErrorStatusIs(err, http.StatusInternalServerError) {
+		t.Fatalf("Expected a %d error, got: %v", http.StatusInternalServerError, err)
+	}
+
+	if attempts != 2 {
+		t.Fatalf("Expected 2 attempts, got %d", attempts)
+	}
+}
+
+func TestClient_doRequest_retry_non_idempotent_unavailable(t *testing.T) {
+	var attempts int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts += 1
+
+		// A 503 may be sent by a proxy after the API created the resource.
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client := &Client{
+		HostURL:      server.URL,
+		HTTPClient:   server.Client(),
+		ApiKey:       "test",
+		RetryMax:     3,
+		RetryWaitMin: time.Millisecond,
+		RetryWaitMax: 10 * time.Millisecond,
+	}
+
+	req, _ := http.NewRequest(http.MethodPost, client.HostURL, strings.NewReader(`{}`))
+	_, err := client.doRequest(req)
+	if !ErrorStatusIs(err, http.StatusServiceUnavailable) {
+		t.Fatalf("Expected a %d error, got: %v", http.StatusServiceUnavailable, err)
+	}
+
+	if attempts != 1 {
+		t.Fatalf("Expected 1 attempt, got %d", attempts)
+	}
+}
+
+func TestClient_doRequest_retry_after(t *testing.T) {
+	var attempts int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts += 1
+
+		if attempts == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := &Client{
+		HostURL:      server.URL,
+		HTTPClient:   server.Client(),
+		ApiKey:       "test",
+		RetryMax:     1,
+		RetryWaitMin: time.Millisecond,
+		RetryWaitMax: 2 * time.Second,
+	}
+
+	start := time.Now()
+	req, _ := http.NewRequest(http.MethodGet, client.HostURL, nil)
+	if _, err := client.doRequest(req); err != nil {
+		t.Fatalf("Request error: %v", err)
+	}
+
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Fatalf("Expected to wait the Retry-After delay, waited %s", elapsed)
+	}
+}
+
+func TestClient_doRequest_retry_after_capped(t *testing.T) {
+	var attempts int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts += 1
+
+		if attempts == 1 {
+			w.Header().Set("Retry-After", "3600")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := &Client{
+		HostURL:      server.URL,
+		HTTPClient:   server.Client(),
+		ApiKey:       "test",
+		RetryMax:     1,
+		RetryWaitMin: time.Millisecond,
+		RetryWaitMax: 10 * time.Millisecond,
+	}
+
+	start := time.Now()
+	req, _ := http.NewRequest(http.MethodGet, client.HostURL, nil)
+	if _, err := client.doRequest(req); err != nil {
+		t.Fatalf("Request error: %v", err)
+	}
+
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("Expected to wait at most RetryWaitMax, waited %s", elapsed)
+	}
+}
+
+func TestClient_doRequest_retry_context_canceled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client := &Client{
+		HostURL:      server.URL,
+		HTTPClient:   server.Client(),
+		ApiKey:       "test",
+		RetryMax:     10,
+		RetryWaitMin: time.Minute,
+		RetryWaitMax: time.Minute,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, client.HostURL, nil)
+	if _, err := client.doRequest(req); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected the context error, got: %v", err)
+	}
+
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("Expected to stop retrying once the context is done, waited %s", elapsed)
+	}
+}
+
+func TestClient_GetServices_context_canceled(t *testing.T) {
+	done := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Simulates a slow API that only answers once the test is over.
+		select {
+		case <-done:
+		case <-r.Context().Done():
+		}
+	}))
+	defer server.Close()
+	defer close(done)
+
+	client := &Client{
+		HostURL:    server.URL,
+		HTTPClient: server.Client(),
+		ApiKey:     "test",
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(20*time.Millisecond, cancel)
+
+	start := time.Now()
+	subdomain := "example-com"
+	if _, err := client.GetServices(ctx, &subdomain); !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected the context error, got: %v", err)
+	}
+
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("Expected the request to be aborted once the context is canceled, waited %s", elapsed)
+	}
+}
+
+func TestClient_GetServices_pagination(t *testing.T) {
+	var serverURL string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The first page links to the second with an absolute URL, the second
+		// to the third with a relative one and the third one is the last page.
+		switch r.URL.Query().Get("after") {
+		case "":
+			fmt.Fprintf(w, `{"services":[{"id":1},{"id":2}],"links":{"prev":null,"next":"%s/status_pages/example-com/services?after=2"}}`, serverURL)
+		case "2":
+			fmt.Fprint(w, `{"services":[{"id":3},{"id":4}],"links":{"prev":null,"next":"/status_pages/example-com/services?after=4"}}`)
+		case "4":
+			fmt.Fprint(w, `{"services":[{"id":5}],"links":{"prev":null,"next":null}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	serverURL = server.URL
+
+	client := &Client{
+		HostURL:    server.URL,
+		HTTPClient: server.Client(),
+		ApiKey:     "test",
+	}
+
+	subdomain := "example-com"
+	services, err := client.GetServices(context.Background(), &subdomain)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(*services) != 5 || (*services)[4].ID != 5 {
+		t.Fatalf("Expected the 5 services of the 3 pages, got: %+v", *services)
+	}
+
+	var pages int
+	err = client.ListServices(context.Background(), &subdomain, ServicesQuery{}, func(page []Service) bool {
+		pages++
+		return false
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if pages != 1 {
+		t.Fatalf("Expected the pagination to stop after the first page, got %d pages", pages)
+	}
+}
+
+func TestClient_GetServices_pagination_other_host(t *testing.T) {
+	var leaked bool
+	other := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		leaked = r.Header.Get("Authorization") != ""
+		fmt.Fprint(w, `{"services":[],"links":{"prev":null,"next":null}}`)
+	}))
+	defer other.Close()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"services":[{"id":1}],"links":{"prev":null,"next":"%s/status_pages/example-com/services?after=1"}}`, other.URL)
+	}))
+	defer server.Close()
+
+	client := &Client{
+		HostURL:    server.URL,
+		HTTPClient: server.Client(),
+		ApiKey:     "test",
+	}
+
+	subdomain := "example-com"
+	_, err := client.GetServices(context.Background(), &subdomain)
+	if err == nil || !strings.Contains(err.Error(), "doesn't point to") {
+		t.Fatalf("Expected the next page link to another host to be rejected, got: %v", err)
+	}
+
+	if leaked {
+		t.Fatalf("Expected the API key not to be sent to another host")
+	}
+}
+
+func TestClient_ListServices_query(t *testing.T) {
+	var queries []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.RawQuery)
+
+		if r.URL.Query().Get("after") == "" {
+			fmt.Fprint(w, `{"services":[{"id":1}],"links":{"prev":null,"next":"1"}}`)
+			return
+		}
+		fmt.Fprint(w, `{"services":[{"id":2}],"links":{"prev":null,"next":null}}`)
+	}))
+	defer server.Close()
+
+	client := &Client{
+		HostURL:    server.URL,
+		HTTPClient: server.Client(),
+		ApiKey:     "test",
+	}
+
+	subdomain := "example-com"
+	isUp, parentID := false, ""
+	err := client.ListServices(context.Background(), &subdomain, ServicesQuery{IsUp: &isUp, ParentID: &parentID}, func(page []Service) bool {
+		return true
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// The filters are kept on every page
+	expected := []string{"is_up=false&parent_id=", "after=1&is_up=false&parent_id="}
+	if strings.Join(queries, " ") != strings.Join(expected, " ") {
+		t.Fatalf("Expected the queries %v, got: %v", expected, queries)
+	}
+}
+
+func TestClient_GetMetrics_pagination(t *testing.T) {
+	var queries []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.RawQuery)
+
+		// The API answers with a bare cursor and finally loops on the same page.
+		switch r.URL.Query().Get("after") {
+		case "":
+			fmt.Fprint(w, `{"metrics":[{"id":1}],"links":{"next":"cursor-1"}}`)
+		default:
+			fmt.Fprint(w, `{"metrics":[{"id":2}],"links":{"next":"cursor-1"}}`)
+		}
+	}))
+	defer server.Close()
+
+	client := &Client{
+		HostURL:    server.URL,
+		HTTPClient: server.Client(),
+		ApiKey:     "test",
+	}
+
+	_, err := client.GetMetrics(context.Background(), "example-com", MetricsQuery{Limit: 1})
+	if err == nil || !strings.Contains(err.Error(), "pagination loop") {
+		t.Fatalf("Expected a pagination loop error, got: %v", err)
+	}
+
+	expected := []string{"limit=1", "after=cursor-1&limit=1"}
+	if strings.Join(queries, " ") != strings.Join(expected, " ") {
+		t.Fatalf("Expected the queries %v, got: %v", expected, queries)
+	}
+}
+
+func TestClient_CreateSubscribers_batches(t *testing.T) {
+	var batches []int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body subscribersResponse
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		batches = append(batches, len(body.Subscribers))
+
+		// The third batch is rejected, the subscribers of the first two are created.
+		if len(batches) == 3 {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			fmt.Fprint(w, `{"errors":{"email":["is invalid"]}}`)
+			return
+		}
+
+		for i := range body.Subscribers {
+			body.Subscribers[i].ID = int64(len(batches)*1000 + i)
+		}
+		_ = json.NewEncoder(w).Encode(body)
+	}))
+	defer server.Close()
+
+	client := &Client{
+		HostURL:    server.URL,
+		HTTPClient: server.Client(),
+		ApiKey:     "test",
+	}
+
+	subscribers := make([]Subscriber, 2*SubscribersBatchSize+1)
+	for i := range subscribers {
+		subscribers[i] = Subscriber{Type: "email", Email: fmt.Sprintf("user-%d@example.com", i)}
+	}
+
+	subdomain := "example-com"
+	created, err := client.CreateSubscribers(context.Background(), subscribers, &subdomain)
+	if err == nil {
+		t.Fatal("Expected the error of the third batch")
+	}
+
+	expected := []int{SubscribersBatchSize, SubscribersBatchSize, 1}
+	if fmt.Sprint(batches) != fmt.Sprint(expected) {
+		t.Fatalf("Expected the batches %v, got: %v", expected, batches)
+	}
+
+	if len(created) != 2*SubscribersBatchSize || created[SubscribersBatchSize].ID != 2000 {
+		t.Fatalf("Expected the subscribers of the first two batches to be returned, got %d subscribers", len(created))
+	}
+}
+
+func TestClient_doRequest_validation_errors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		fmt.Fprint(w, `{"errors": {
+			"subdomain": ["has already been taken", "is reserved"],
+			"domain_config": {"domain": ["is invalid"]},
+			"translations": {"en": {"public_company_name": "can't be blank"}},
+			"monitoring_options": {"headers": [null, {}, {"key": ["can't be blank"]}]}
+		}}`)
+	}))
+	defer server.Close()
+
+	client := &Client{
+		HostURL:    server.URL,
+		HTTPClient: server.Client(),
+		ApiKey:     "test",
+	}
+
+	req, _ := http.NewRequest(http.MethodPost, client.HostURL, strings.NewReader("{}"))
+	_, err := client.doRequest(req.WithContext(context.Background()))
+	if !ErrorStatusIs(err, http.StatusUnprocessableEntity) {
+		t.Fatalf("Expected an unprocessable entity error, got: %v", err)
+	}
+
+	expected := []FieldError{
+		{Field: "domain_config.domain", Messages: []string{"is invalid"}},
+		{Field: "monitoring_options.headers.2.key", Messages: []string{"can't be blank"}},
+		{Field: "subdomain", Messages: []string{"has already been taken", "is reserved"}},
+		{Field: "translations.en.public_company_name", Messages: []string{"can't be blank"}},
+	}
+	if fmt.Sprint(ValidationErrors(err)) != fmt.Sprint(expected) {
+		t.Fatalf("Expected the field errors %v, got: %v", expected, ValidationErrors(err))
+	}
+
+	if fieldErrors := ValidationErrors(NewError(http.StatusNotFound, []byte(`{"errors": {"detail": "Not Found"}}`))); fieldErrors != nil {
+		t.Fatalf("Expected no field errors for a generic error, got: %v", fieldErrors)
+	}
+}
+
+func TestClient_doRequest_logging(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"webhook":{"url":"https://example.com","secret":"response-secret"}}`))
+	}))
+	defer server.Close()
+
+	client := &Client{
+		HostURL:    server.URL,
+		HTTPClient: server.Client(),
+		ApiKey:     "uk_secret_api_key",
+	}
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	req, _ := http.NewRequestWithContext(ctx, http.MethodPost, client.HostURL, strings.NewReader(`{"webhook":{"secret":"request-secret"}}`))
+	if _, err := client.doRequest(req); err != nil {
+		t.Fatalf("Request error: %v", err)
+	}
+
+	logs := output.String()
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatalf("Unable to decode the logs: %v", err)
+	}
+
+	var traced bool
+	for _, entry := range entries {
+		if entry["@module"] != "provider."+LogSubsystem {
+			t.Errorf("Unexpected log module: %v", entry["@module"])
+		}
+
+		if entry["@message"] == "StatusPal API request and response bodies" {
+			traced = true
+
+			if entry["request_body"] != `{"webhook":{"secret":"***"}}` {
+				t.Errorf("Unexpected request body: %v", entry["request_body"])
+			}
+			if entry["response_body"] != `{"webhook":{"secret":"***","url":"https://example.com"}}` {
+				t.Errorf("Unexpected response body: %v", entry["response_body"])
+			}
+		}
+	}
+
+	if !traced {
+		t.Fatal("The request wasn't traced")
+	}
+
+	for _, secret := range []string{"uk_secret_api_key", "request-secret", "response-secret"} {
+		if strings.Contains(logs, secret) {
+			t.Errorf("The logs leak %q", secret)
+		}
+	}
+}
+
+func TestClient_ConfigureTransport(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	caBundle := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+
+	testCases := map[string]struct {
+		config  TransportConfig
+		wantErr bool
+	}{
+		"untrusted certificate": {
+			config:  TransportConfig{},
+			wantErr: true,
+		},
+		"ca bundle": {
+			config: TransportConfig{CABundle: caBundle},
+		},
+		"insecure skip verify": {
+			config: TransportConfig{InsecureSkipVerify: true},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			client := &Client{
+				HostURL:    server.URL,
+				HTTPClient: &http.Client{},
+				ApiKey:     "test",
+			}
+			if err := client.ConfigureTransport(testCase.config); err != nil {
+				t.Fatalf("Unable to configure the transport: %v", err)
+			}
+
+			req, _ := http.NewRequest(http.MethodGet, client.HostURL, nil)
+			_, err := client.doRequest(req)
+			if testCase.wantErr && err == nil {
+				t.Fatal("Expected a TLS error")
+			}
+			if !testCase.wantErr && err != nil {
+				t.Fatalf("Request error: %v", err)
+			}
+		})
+	}
+
+	client := &Client{HTTPClient: &http.Client{}}
+	if err := client.ConfigureTransport(TransportConfig{CABundle: "not a certificate"}); err == nil {
+		t.Fatal("Expected an invalid CA bundle error")
+	}
+}
+
+func TestClient_ConfigureTransport_proxy(t *testing.T) {
+	var proxied string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// A proxied request carries the absolute URL of the target.
+		proxied = r.URL.String()
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer proxy.Close()
+
+	client := &Client{
+		HostURL:    "http://statuspal.invalid/api/v2",
+		HTTPClient: &http.Client{},
+		ApiKey:     "test",
+	}
+	if err := client.ConfigureTransport(TransportConfig{ProxyURL: proxy.URL}); err != nil {
+		t.Fatalf("Unable to configure the transport: %v", err)
+	}
+
+	req, _ := http.NewRequest(http.MethodGet, client.HostURL+"/status_pages", nil)
+	if _, err := client.doRequest(req); err != nil {
+		t.Fatalf("Request error: %v", err)
+	}
+
+	if proxied != "http://statuspal.invalid/api/v2/status_pages" {
+		t.Fatalf("The request wasn't sent through the proxy, got: %q", proxied)
+	}
+}
@@ -1,14 +1,29 @@
 package statuspal
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
+	"sort"
+	"strconv"
 )
 
 // Error represents a custom error that cares the HTTP status, and the body of the failure request.
 type Error struct {
 	Status int
 	Body   string
+	// FieldErrors holds the validation errors of the request fields, parsed from
+	// an `{"errors": {"field": ["message"]}}` response body.
+	FieldErrors []FieldError
+}
+
+// FieldError represents the validation error of a request field.
+type FieldError struct {
+	// Field is the dotted path of the field in the request body, e.g.
+	// "subdomain", "domain_config.domain" or "monitoring_options.headers.0.key".
+	Field    string
+	Messages []string
 }
 
 func (e Error) Error() string {
@@ -17,8 +32,9 @@ func (e Error) Error() string {
 
 func NewError(status int, body []byte) error {
 	return &Error{
-		Status: status,
-		Body:   string(body),
+		Status:      status,
+		Body:        string(body),
+		FieldErrors: parseFieldErrors(body),
 	}
 }
 
@@ -36,3 +52,86 @@ func ErrorStatusIs(err error, status int) bool {
 func ErrorNotFound(err error) bool {
 	return ErrorStatusIs(err, http.StatusNotFound)
 }
+
+// ValidationErrors returns the field errors of a HTTP error, if any.
+func ValidationErrors(err error) []FieldError {
+	var e *Error
+	if !errors.As(err, &e) {
+		return nil
+	}
+
+	return e.FieldErrors
+}
+
+// parseFieldErrors parses the field errors of a validation error body, e.g.
+// `{"errors": {"subdomain": ["has already been taken"], "translations": {"en": {"name": ["can't be blank"]}}}}`.
+// The fields are sorted by path, and nothing is returned if the body doesn't match this format.
+func parseFieldErrors(body []byte) []FieldError {
+	response := struct {
+		Errors map[string]json.RawMessage `json:"errors"`
+	}{}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil
+	}
+
+	var fieldErrors []FieldError
+	for field, value := range response.Errors {
+		// `{"errors": {"detail": "Not Found"}}` is a generic error, not a field error.
+		if field == "detail" {
+			continue
+		}
+
+		fieldErrors = append(fieldErrors, collectFieldErrors(field, value)...)
+	}
+
+	sort.Slice(fieldErrors, func(i, j int) bool {
+		return fieldErrors[i].Field < fieldErrors[j].Field
+	})
+
+	return fieldErrors
+}
+
+// collectFieldErrors walks the errors of a field, which are either a message, a
+// list of messages, the errors of the nested fields or the errors of the list items.
+func collectFieldErrors(field string, value json.RawMessage) []FieldError {
+	// The items without errors of a list are usually `null` or `{}`.
+	if string(value) == "null" {
+		return nil
+	}
+
+	var message string
+	if err := json.Unmarshal(value, &message); err == nil {
+		return []FieldError{{Field: field, Messages: []string{message}}}
+	}
+
+	var messages []string
+	if err := json.Unmarshal(value, &messages); err == nil {
+		if len(messages) == 0 {
+			return nil
+		}
+
+		return []FieldError{{Field: field, Messages: messages}}
+	}
+
+	var nested map[string]json.RawMessage
+	if err := json.Unmarshal(value, &nested); err == nil {
+		var fieldErrors []FieldError
+		for key, nestedValue := range nested {
+			fieldErrors = append(fieldErrors, collectFieldErrors(field+"."+key, nestedValue)...)
+		}
+
+		return fieldErrors
+	}
+
+	var items []json.RawMessage
+	if err := json.Unmarshal(value, &items); err == nil {
+		var fieldErrors []FieldError
+		for i, item := range items {
+			fieldErrors = append(fieldErrors, collectFieldErrors(field+"."+strconv.Itoa(i), item)...)
+		}
+
+		return fieldErrors
+	}
+
+	return nil
+}
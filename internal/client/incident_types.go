@@ -0,0 +1,107 @@
+package statuspal
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+type IncidentTypeResponse struct {
+	IncidentType IncidentType `json:"incident_type"`
+}
+
+// GetIncidentType - Returns specific incident type from the status page.
+func (c *Client) GetIncidentType(ctx context.Context, statusPageSubdomain *string, incidentTypeID *string) (*IncidentType, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/status_pages/%s/incident_types/%s", c.HostURL, *statusPageSubdomain, *incidentTypeID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	response := IncidentTypeResponse{}
+	err = json.Unmarshal(*body, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.IncidentType, nil
+}
+
+// CreateIncidentType - Create new incident type in the status page.
+func (c *Client) CreateIncidentType(ctx context.Context, incidentType *IncidentType, statusPageSubdomain *string) (*IncidentType, error) {
+	rb, err := json.Marshal(IncidentTypeResponse{IncidentType: *incidentType})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/status_pages/%s/incident_types", c.HostURL, *statusPageSubdomain), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	response := IncidentTypeResponse{}
+	err = json.Unmarshal(*body, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.IncidentType, nil
+}
+
+// UpdateIncidentType - Update an incident type in the status page.
+func (c *Client) UpdateIncidentType(ctx context.Context, incidentType *IncidentType, statusPageSubdomain *string, incidentTypeID *string) (*IncidentType, error) {
+	rb, err := json.Marshal(IncidentTypeResponse{IncidentType: *incidentType})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/status_pages/%s/incident_types/%s", c.HostURL, *statusPageSubdomain, *incidentTypeID), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	response := IncidentTypeResponse{}
+	err = json.Unmarshal(*body, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.IncidentType, nil
+}
+
+// DeleteIncidentType - Delete an incident type in the status page.
+func (c *Client) DeleteIncidentType(ctx context.Context, statusPageSubdomain *string, incidentTypeID *string) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/status_pages/%s/incident_types/%s", c.HostURL, *statusPageSubdomain, *incidentTypeID), nil)
+	if err != nil {
+		return err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	convertedBody := string(*body)
+	if convertedBody != `""` {
+		return errors.New(convertedBody)
+	}
+
+	return nil
+}
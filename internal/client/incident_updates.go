@@ -0,0 +1,107 @@
+package statuspal
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+type IncidentUpdateResponse struct {
+	IncidentUpdate IncidentUpdate `json:"incident_update"`
+}
+
+// GetIncidentUpdate - Returns specific update of an incident from the status page.
+func (c *Client) GetIncidentUpdate(ctx context.Context, statusPageSubdomain *string, incidentID *string, updateID *string) (*IncidentUpdate, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/status_pages/%s/incidents/%s/updates/%s", c.HostURL, *statusPageSubdomain, *incidentID, *updateID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	response := IncidentUpdateResponse{}
+	err = json.Unmarshal(*body, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.IncidentUpdate, nil
+}
+
+// CreateIncidentUpdate - Post new update on an incident of the status page.
+func (c *Client) CreateIncidentUpdate(ctx context.Context, update *IncidentUpdate, statusPageSubdomain *string, incidentID *string) (*IncidentUpdate, error) {
+	rb, err := json.Marshal(IncidentUpdateResponse{IncidentUpdate: *update})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/status_pages/%s/incidents/%s/updates", c.HostURL, *statusPageSubdomain, *incidentID), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	response := IncidentUpdateResponse{}
+	err = json.Unmarshal(*body, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.IncidentUpdate, nil
+}
+
+// UpdateIncidentUpdate - Update an update of an incident of the status page.
+func (c *Client) UpdateIncidentUpdate(ctx context.Context, update *IncidentUpdate, statusPageSubdomain *string, incidentID *string, updateID *string) (*IncidentUpdate, error) {
+	rb, err := json.Marshal(IncidentUpdateResponse{IncidentUpdate: *update})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/status_pages/%s/incidents/%s/updates/%s", c.HostURL, *statusPageSubdomain, *incidentID, *updateID), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	response := IncidentUpdateResponse{}
+	err = json.Unmarshal(*body, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.IncidentUpdate, nil
+}
+
+// DeleteIncidentUpdate - Delete an update of an incident of the status page.
+func (c *Client) DeleteIncidentUpdate(ctx context.Context, statusPageSubdomain *string, incidentID *string, updateID *string) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/status_pages/%s/incidents/%s/updates/%s", c.HostURL, *statusPageSubdomain, *incidentID, *updateID), nil)
+	if err != nil {
+		return err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	convertedBody := string(*body)
+	if convertedBody != `""` {
+		return errors.New(convertedBody)
+	}
+
+	return nil
+}
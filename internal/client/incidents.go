@@ -0,0 +1,161 @@
+package statuspal
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+type IncidentResponse struct {
+	Incident Incident `json:"incident"`
+}
+
+type incidentsResponse struct {
+	Incidents []Incident `json:"incidents"`
+	Links     *Links     `json:"links"`
+}
+
+// closeIncidentRequest is the request body closing an incident, it only sets its end time.
+type closeIncidentRequest struct {
+	Incident struct {
+		EndsAt string `json:"ends_at"`
+	} `json:"incident"`
+}
+
+// ListIncidents - Calls fn with each page of incidents from the status page.
+func (c *Client) ListIncidents(ctx context.Context, statusPageSubdomain *string, fn PageFunc[Incident]) error {
+	return paginate(ctx, c, fmt.Sprintf("%s/status_pages/%s/incidents", c.HostURL, *statusPageSubdomain), func(body []byte) ([]Incident, *Links, error) {
+		response := incidentsResponse{}
+		if err := json.Unmarshal(body, &response); err != nil {
+			return nil, nil, err
+		}
+
+		return response.Incidents, response.Links, nil
+	}, fn)
+}
+
+// GetIncident - Returns specific incident from the status page.
+func (c *Client) GetIncident(ctx context.Context, statusPageSubdomain *string, incidentID *string) (*Incident, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/status_pages/%s/incidents/%s", c.HostURL, *statusPageSubdomain, *incidentID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	response := IncidentResponse{}
+	err = json.Unmarshal(*body, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.Incident, nil
+}
+
+// CreateIncident - Create new incident in the status page.
+func (c *Client) CreateIncident(ctx context.Context, incident *Incident, statusPageSubdomain *string) (*Incident, error) {
+	rb, err := json.Marshal(IncidentResponse{Incident: *incident})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/status_pages/%s/incidents", c.HostURL, *statusPageSubdomain), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	response := IncidentResponse{}
+	err = json.Unmarshal(*body, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.Incident, nil
+}
+
+// UpdateIncident - Update an incident in the status page.
+func (c *Client) UpdateIncident(ctx context.Context, incident *Incident, statusPageSubdomain *string, incidentID *string) (*Incident, error) {
+	rb, err := json.Marshal(IncidentResponse{Incident: *incident})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/status_pages/%s/incidents/%s", c.HostURL, *statusPageSubdomain, *incidentID), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	response := IncidentResponse{}
+	err = json.Unmarshal(*body, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.Incident, nil
+}
+
+// CloseIncident - Close an incident in the status page, by setting its end time.
+// The incident stays in the status page history.
+func (c *Client) CloseIncident(ctx context.Context, statusPageSubdomain *string, incidentID *string, endsAt string) (*Incident, error) {
+	request := closeIncidentRequest{}
+	request.Incident.EndsAt = endsAt
+
+	rb, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/status_pages/%s/incidents/%s", c.HostURL, *statusPageSubdomain, *incidentID), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	response := IncidentResponse{}
+	err = json.Unmarshal(*body, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.Incident, nil
+}
+
+// DeleteIncident - Delete an incident in the status page.
+func (c *Client) DeleteIncident(ctx context.Context, statusPageSubdomain *string, incidentID *string) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/status_pages/%s/incidents/%s", c.HostURL, *statusPageSubdomain, *incidentID), nil)
+	if err != nil {
+		return err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	convertedBody := string(*body)
+	if convertedBody != `""` {
+		return errors.New(convertedBody)
+	}
+
+	return nil
+}
@@ -0,0 +1,135 @@
+package statuspal
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// LogSubsystem is the name of the tflog subsystem tracing the StatusPal API requests.
+// Its level can be set independently with the TF_LOG_PROVIDER_STATUSPAL environment variable.
+const LogSubsystem = "statuspal"
+
+// redactedValue replaces the sensitive values in the logs.
+const redactedValue = "***"
+
+// sensitiveBodyKeys are the JSON keys whose values are never written to the logs.
+var sensitiveBodyKeys = map[string]bool{
+	"api_key":       true,
+	"authorization": true,
+	"credentials":   true,
+	"password":      true,
+	"secret":        true,
+	"token":         true,
+	// Chat incoming webhook URLs embed the token of the channel.
+	"webhook_url": true,
+	// Monitoring headers usually carry the credentials of the monitored service.
+	"headers": true,
+}
+
+// newLogContext returns a context logging to the StatusPal subsystem, with the API key masked.
+func (c *Client) newLogContext(ctx context.Context) context.Context {
+	ctx = tflog.NewSubsystem(ctx, LogSubsystem, tflog.WithLevelFromEnv("TF_LOG_PROVIDER", LogSubsystem))
+	ctx = tflog.SubsystemMaskFieldValuesWithFieldKeys(ctx, LogSubsystem, "authorization")
+
+	if c.ApiKey != "" {
+		ctx = tflog.SubsystemMaskAllFieldValuesStrings(ctx, LogSubsystem, c.ApiKey)
+		ctx = tflog.SubsystemMaskMessageStrings(ctx, LogSubsystem, c.ApiKey)
+	}
+
+	return ctx
+}
+
+// logRequest traces a single attempt of a request sent to the StatusPal API.
+func logRequest(
+	ctx context.Context,
+	req *http.Request,
+	reqBody []byte,
+	res *http.Response,
+	resBody []byte,
+	duration time.Duration,
+	err error,
+) {
+	fields := map[string]any{
+		"method":      req.Method,
+		"url":         req.URL.String(),
+		"duration_ms": duration.Milliseconds(),
+	}
+	if err != nil {
+		fields["error"] = err.Error()
+	} else {
+		fields["status"] = res.StatusCode
+	}
+
+	tflog.SubsystemDebug(ctx, LogSubsystem, "Sent StatusPal API request", fields)
+
+	tflog.SubsystemTrace(ctx, LogSubsystem, "StatusPal API request and response bodies", map[string]any{
+		"method":        req.Method,
+		"url":           req.URL.String(),
+		"request_body":  redactBody(reqBody),
+		"response_body": redactBody(resBody),
+	})
+}
+
+// readRequestBody returns a copy of the request body, without consuming it.
+func readRequestBody(req *http.Request) []byte {
+	if req.GetBody == nil {
+		return nil
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return nil
+	}
+	defer body.Close()
+
+	content, err := io.ReadAll(body)
+	if err != nil {
+		return nil
+	}
+
+	return content
+}
+
+// redactBody returns the body as a string with the values of the sensitive JSON keys replaced.
+func redactBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	var value any
+	if err := json.Unmarshal(body, &value); err != nil {
+		return string(body)
+	}
+
+	redacted, err := json.Marshal(redactValue(value))
+	if err != nil {
+		return string(body)
+	}
+
+	return string(redacted)
+}
+
+func redactValue(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, nested := range v {
+			if sensitiveBodyKeys[strings.ToLower(key)] {
+				v[key] = redactedValue
+				continue
+			}
+			v[key] = redactValue(nested)
+		}
+	case []any:
+		for i, nested := range v {
+			v[i] = redactValue(nested)
+		}
+	}
+
+	return value
+}
@@ -0,0 +1,132 @@
+package statuspal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+type MetricIntegrationBody struct {
+	MetricIntegration MetricIntegration `json:"metric_integration"`
+}
+
+type MetricIntegrationsBody struct {
+	MetricIntegrations []MetricIntegration `json:"metric_integrations"`
+	Links              *Links              `json:"links,omitempty"`
+}
+
+// GetMetricIntegrations retrieves the metric integrations of the status page,
+// following all the pages.
+func (c *Client) GetMetricIntegrations(ctx context.Context, subdomain string) (*[]MetricIntegration, error) {
+	integrations := []MetricIntegration{}
+	if err := c.ListMetricIntegrations(ctx, subdomain, collectAll(&integrations)); err != nil {
+		return nil, err
+	}
+
+	return &integrations, nil
+}
+
+// ListMetricIntegrations calls fn with each page of metric integrations of the status page.
+func (c *Client) ListMetricIntegrations(ctx context.Context, subdomain string, fn PageFunc[MetricIntegration]) error {
+	return paginate(ctx, c, fmt.Sprintf("%s/status_pages/%s/metric_integrations", c.HostURL, subdomain), func(body []byte) ([]MetricIntegration, *Links, error) {
+		response := MetricIntegrationsBody{}
+		if err := json.Unmarshal(body, &response); err != nil {
+			return nil, nil, err
+		}
+
+		return response.MetricIntegrations, response.Links, nil
+	}, fn)
+}
+
+// GetMetricIntegration retrieves a single metric integration by ID.
+func (c *Client) GetMetricIntegration(ctx context.Context, id string, subdomain string) (*MetricIntegration, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/status_pages/%s/metric_integrations/%s", c.HostURL, subdomain, id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var response MetricIntegrationBody
+	err = json.Unmarshal(*body, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.MetricIntegration, nil
+}
+
+// CreateMetricIntegration creates a new metric integration for the status page.
+func (c *Client) CreateMetricIntegration(ctx context.Context, subdomain string, integration *MetricIntegration) (*MetricIntegration, error) {
+	rb, err := json.Marshal(MetricIntegrationBody{
+		MetricIntegration: *integration,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/status_pages/%s/metric_integrations", c.HostURL, subdomain), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var response MetricIntegrationBody
+	err = json.Unmarshal(*body, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.MetricIntegration, nil
+}
+
+// UpdateMetricIntegration updates an existing metric integration on the status page.
+func (c *Client) UpdateMetricIntegration(ctx context.Context, id string, subdomain string, integration *MetricIntegration) (*MetricIntegration, error) {
+	rb, err := json.Marshal(MetricIntegrationBody{
+		MetricIntegration: *integration,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/status_pages/%s/metric_integrations/%s", c.HostURL, subdomain, id), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var response MetricIntegrationBody
+	err = json.Unmarshal(*body, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.MetricIntegration, nil
+}
+
+// DeleteMetricIntegration deletes a metric integration from the status page.
+func (c *Client) DeleteMetricIntegration(ctx context.Context, id string, subdomain string) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/status_pages/%s/metric_integrations/%s", c.HostURL, subdomain, id), nil)
+	if err != nil {
+		return err
+	}
+
+	if _, err := c.doRequest(req); err != nil {
+		return err
+	}
+
+	return nil
+}
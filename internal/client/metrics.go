@@ -1,10 +1,12 @@
 package statuspal
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
 )
 
@@ -25,15 +27,42 @@ type MetricBody struct {
 
 type MetricsBody struct {
 	Metrics []Metric `json:"metrics"`
+	Links   *Links   `json:"links,omitempty"`
+}
+
+// MetricEntry is a data point of a metric, its timestamp is in Unix seconds.
+type MetricEntry struct {
+	Timestamp int64   `json:"timestamp"`
+	Value     float64 `json:"value"`
+}
+
+type MetricEntriesBody struct {
+	Entries []MetricEntry `json:"entries"`
 }
 
 type MetricsQuery struct {
-	Before string `query:"before"`
-	After  string `query:"after"`
-	Limit  int64  `query:"limit"`
+	Before  string `query:"before"`
+	After   string `query:"after"`
+	Limit   int64  `query:"limit"`
+	Type    string `query:"type"`
+	Enabled *bool  `query:"enabled"`
+}
+
+// GetMetrics retrieves the metrics of the status page, following all the pages
+// from the query cursor.
+func (c *Client) GetMetrics(ctx context.Context, statusPageSubdomain string, query MetricsQuery) (*[]Metric, error) {
+	metrics := []Metric{}
+	if err := c.ListMetrics(ctx, statusPageSubdomain, query, collectAll(&metrics)); err != nil {
+		return nil, err
+	}
+
+	return &metrics, nil
 }
 
-func (c *Client) GetMetrics(statusPageSubdomain string, query MetricsQuery) (*[]Metric, error) {
+// ListMetrics calls fn with each page of metrics of the status page matching
+// the query type and enabled filters. The query limit sets the number of
+// metrics per page.
+func (c *Client) ListMetrics(ctx context.Context, statusPageSubdomain string, query MetricsQuery, fn PageFunc[Metric]) error {
 	urlParams := url.Values{}
 	if query.Before != "" {
 		urlParams.Add("before", query.Before)
@@ -44,29 +73,31 @@ func (c *Client) GetMetrics(statusPageSubdomain string, query MetricsQuery) (*[]
 	if query.Limit > 0 {
 		urlParams.Add("limit", fmt.Sprintf("%d", query.Limit))
 	}
-
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/status_pages/%s/metrics%s", c.HostURL, statusPageSubdomain, urlParams.Encode()), nil)
-	if err != nil {
-		return nil, err
+	if query.Type != "" {
+		urlParams.Add("type", query.Type)
 	}
-
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
+	if query.Enabled != nil {
+		urlParams.Add("enabled", strconv.FormatBool(*query.Enabled))
 	}
 
-	response := MetricsBody{}
-	err = json.Unmarshal(*body, &response)
-	if err != nil {
-		return nil, err
+	pageURL := fmt.Sprintf("%s/status_pages/%s/metrics", c.HostURL, statusPageSubdomain)
+	if len(urlParams) > 0 {
+		pageURL += "?" + urlParams.Encode()
 	}
 
-	return &response.Metrics, nil
+	return paginate(ctx, c, pageURL, func(body []byte) ([]Metric, *Links, error) {
+		response := MetricsBody{}
+		if err := json.Unmarshal(body, &response); err != nil {
+			return nil, nil, err
+		}
+
+		return response.Metrics, response.Links, nil
+	}, fn)
 }
 
 // GetMetric retrieves a single metric by ID.
-func (c *Client) GetMetric(id string, subdomain string) (*Metric, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/status_pages/%s/metrics/%s", c.HostURL, subdomain, id), nil)
+func (c *Client) GetMetric(ctx context.Context, id string, subdomain string) (*Metric, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/status_pages/%s/metrics/%s", c.HostURL, subdomain, id), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -86,7 +117,7 @@ func (c *Client) GetMetric(id string, subdomain string) (*Metric, error) {
 }
 
 // CreateMetric creates a new metric for the status page.
-func (c *Client) CreateMetric(subdomain string, metric *Metric) (*Metric, error) {
+func (c *Client) CreateMetric(ctx context.Context, subdomain string, metric *Metric) (*Metric, error) {
 	rb, err := json.Marshal(MetricBody{
 		Metric: *metric,
 	})
@@ -94,7 +125,7 @@ func (c *Client) CreateMetric(subdomain string, metric *Metric) (*Metric, error)
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/status_pages/%s/metrics", c.HostURL, subdomain), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/status_pages/%s/metrics", c.HostURL, subdomain), strings.NewReader(string(rb)))
 	if err != nil {
 		return nil, err
 	}
@@ -114,7 +145,7 @@ func (c *Client) CreateMetric(subdomain string, metric *Metric) (*Metric, error)
 }
 
 // UpdateMetric updates an existing metric on the status page.
-func (c *Client) UpdateMetric(id string, subdomain string, metric *Metric) (*Metric, error) {
+func (c *Client) UpdateMetric(ctx context.Context, id string, subdomain string, metric *Metric) (*Metric, error) {
 	rb, err := json.Marshal(MetricBody{
 		Metric: *metric,
 	})
@@ -122,7 +153,7 @@ func (c *Client) UpdateMetric(id string, subdomain string, metric *Metric) (*Met
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/status_pages/%s/metrics/%s", c.HostURL, subdomain, id), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/status_pages/%s/metrics/%s", c.HostURL, subdomain, id), strings.NewReader(string(rb)))
 	if err != nil {
 		return nil, err
 	}
@@ -142,8 +173,29 @@ func (c *Client) UpdateMetric(id string, subdomain string, metric *Metric) (*Met
 }
 
 // DeleteMetric deletes a metric from the status page.
-func (c *Client) DeleteMetric(id string, subdomain string) error {
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/status_pages/%s/metrics/%s", c.HostURL, subdomain, id), nil)
+func (c *Client) DeleteMetric(ctx context.Context, id string, subdomain string) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/status_pages/%s/metrics/%s", c.HostURL, subdomain, id), nil)
+	if err != nil {
+		return err
+	}
+
+	if _, err := c.doRequest(req); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// CreateMetricEntries submits data points to a metric of the status page.
+func (c *Client) CreateMetricEntries(ctx context.Context, id string, subdomain string, entries []MetricEntry) error {
+	rb, err := json.Marshal(MetricEntriesBody{
+		Entries: entries,
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/status_pages/%s/metrics/%s/entries", c.HostURL, subdomain, id), strings.NewReader(string(rb)))
 	if err != nil {
 		return err
 	}
@@ -166,9 +166,137 @@ type MonitoringOptionsHeader struct {
 	Value string `json:"value"`
 }
 
+// Incident struct, an incident or a scheduled maintenance of the status page.
+type Incident struct {
+	ID           int64                `json:"id"`
+	Title        string               `json:"title"`
+	Type         string               `json:"type"`
+	StartsAt     string               `json:"starts_at,omitempty"`
+	EndsAt       *string              `json:"ends_at,omitempty"`
+	ServiceIDs   []int64              `json:"service_ids"`
+	Updates      []IncidentUpdate     `json:"updates,omitempty"`
+	Translations IncidentTranslations `json:"translations"`
+	Notify       bool                 `json:"notify"`
+	Tweet        bool                 `json:"tweet"`
+	Url          string               `json:"url,omitempty"`
+	InsertedAt   string               `json:"inserted_at,omitempty"`
+	UpdatedAt    string               `json:"updated_at,omitempty"`
+}
+
+type IncidentTranslations map[string]IncidentTranslation
+
+type IncidentTranslation struct {
+	Title string `json:"title"`
+}
+
+// IncidentUpdate struct, an update posted on an incident.
+type IncidentUpdate struct {
+	ID           int64                      `json:"id,omitempty"`
+	Type         string                     `json:"type"`
+	Subtype      string                     `json:"subtype,omitempty"`
+	Description  string                     `json:"description"`
+	Translations IncidentUpdateTranslations `json:"translations,omitempty"`
+	Notify       bool                       `json:"notify,omitempty"`
+	Tweet        bool                       `json:"tweet,omitempty"`
+	InsertedAt   string                     `json:"inserted_at,omitempty"`
+	UpdatedAt    string                     `json:"updated_at,omitempty"`
+}
+
+type IncidentUpdateTranslations map[string]IncidentUpdateTranslation
+
+type IncidentUpdateTranslation struct {
+	Description string `json:"description"`
+}
+
+// Subscriber struct, a subscriber of the status page notified by email, SMS or webhook.
+type Subscriber struct {
+	ID          int64   `json:"id,omitempty"`
+	Type        string  `json:"type"`
+	Email       string  `json:"email,omitempty"`
+	PhoneNumber string  `json:"phone_number,omitempty"`
+	Url         string  `json:"url,omitempty"`
+	ServiceIDs  []int64 `json:"service_ids"`
+	Confirmed   bool    `json:"confirmed,omitempty"`
+	InsertedAt  string  `json:"inserted_at,omitempty"`
+	UpdatedAt   string  `json:"updated_at,omitempty"`
+}
+
+// Webhook struct, an outgoing webhook notified about the events of the status page.
+type Webhook struct {
+	ID         int64    `json:"id,omitempty"`
+	Url        string   `json:"url"`
+	Secret     *string  `json:"secret,omitempty"`
+	Events     []string `json:"events"`
+	ServiceIDs []int64  `json:"service_ids"`
+	InsertedAt string   `json:"inserted_at,omitempty"`
+	UpdatedAt  string   `json:"updated_at,omitempty"`
+}
+
+// ChatIntegration struct, a chat channel the status page posts its updates to.
+type ChatIntegration struct {
+	ID         int64  `json:"id,omitempty"`
+	Platform   string `json:"platform"`
+	WebhookUrl string `json:"webhook_url,omitempty"`
+	Channel    string `json:"channel,omitempty"`
+	InsertedAt string `json:"inserted_at,omitempty"`
+	UpdatedAt  string `json:"updated_at,omitempty"`
+}
+
+// IncidentType struct, a custom incident type of the status page.
+type IncidentType struct {
+	ID           int64                    `json:"id,omitempty"`
+	Name         string                   `json:"name"`
+	Color        string                   `json:"color"`
+	Severity     string                   `json:"severity"`
+	Translations IncidentTypeTranslations `json:"translations"`
+	InsertedAt   string                   `json:"inserted_at,omitempty"`
+	UpdatedAt    string                   `json:"updated_at,omitempty"`
+}
+
+type IncidentTypeTranslations map[string]IncidentTypeTranslation
+
+type IncidentTypeTranslation struct {
+	Name string `json:"name"`
+}
+
+// Notice struct, an informational banner displayed on the status page.
+type Notice struct {
+	ID           int64              `json:"id,omitempty"`
+	Message      string             `json:"message"`
+	Translations NoticeTranslations `json:"translations"`
+	StartsAt     *string            `json:"starts_at"`
+	EndsAt       *string            `json:"ends_at"`
+	Visible      bool               `json:"visible"`
+	InsertedAt   string             `json:"inserted_at,omitempty"`
+	UpdatedAt    string             `json:"updated_at,omitempty"`
+}
+
+type NoticeTranslations map[string]NoticeTranslation
+
+type NoticeTranslation struct {
+	Message string `json:"message"`
+}
+
+// TeamMember struct, a user of the organization or the invitation sent to join it.
+type TeamMember struct {
+	ID                   int64   `json:"id,omitempty"`
+	Email                string  `json:"email"`
+	Role                 string  `json:"role"`
+	Name                 string  `json:"name,omitempty"`
+	State                string  `json:"state,omitempty"`
+	InvitationSentAt     *string `json:"invitation_sent_at,omitempty"`
+	InvitationAcceptedAt *string `json:"invitation_accepted_at,omitempty"`
+	InsertedAt           string  `json:"inserted_at,omitempty"`
+	UpdatedAt            string  `json:"updated_at,omitempty"`
+}
+
+// NotificationRecipient struct, an internal recipient of the monitoring alerts of the status page.
 type NotificationRecipient struct {
-	ID    int64  `json:"id"`
-	Email string `json:"email"` // Add more fields as needed
+	ID         int64  `json:"id,omitempty"`
+	Email      string `json:"email"`
+	Name       string `json:"name,omitempty"`
+	InsertedAt string `json:"inserted_at,omitempty"`
+	UpdatedAt  string `json:"updated_at,omitempty"`
 }
 
 // Metric represents a metric on the status page.
@@ -188,3 +316,13 @@ type Metric struct {
 	FeaturedNumber  string `json:"featured_number"`
 	IntegrationID   *int64 `json:"integration_id"`
 }
+
+// MetricIntegration struct, the credentials of a monitoring service the metrics pull their data from.
+type MetricIntegration struct {
+	ID          int64             `json:"id,omitempty"`
+	Type        string            `json:"type"`
+	Name        string            `json:"name"`
+	Credentials map[string]string `json:"credentials,omitempty"`
+	InsertedAt  string            `json:"inserted_at,omitempty"`
+	UpdatedAt   string            `json:"updated_at,omitempty"`
+}
@@ -0,0 +1,107 @@
+package statuspal
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+type NoticeResponse struct {
+	Notice Notice `json:"notice"`
+}
+
+// GetNotice - Returns specific notice from the status page.
+func (c *Client) GetNotice(ctx context.Context, statusPageSubdomain *string, noticeID *string) (*Notice, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/status_pages/%s/notices/%s", c.HostURL, *statusPageSubdomain, *noticeID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	response := NoticeResponse{}
+	err = json.Unmarshal(*body, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.Notice, nil
+}
+
+// CreateNotice - Create new notice in the status page.
+func (c *Client) CreateNotice(ctx context.Context, notice *Notice, statusPageSubdomain *string) (*Notice, error) {
+	rb, err := json.Marshal(NoticeResponse{Notice: *notice})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/status_pages/%s/notices", c.HostURL, *statusPageSubdomain), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	response := NoticeResponse{}
+	err = json.Unmarshal(*body, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.Notice, nil
+}
+
+// UpdateNotice - Update a notice in the status page.
+func (c *Client) UpdateNotice(ctx context.Context, notice *Notice, statusPageSubdomain *string, noticeID *string) (*Notice, error) {
+	rb, err := json.Marshal(NoticeResponse{Notice: *notice})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/status_pages/%s/notices/%s", c.HostURL, *statusPageSubdomain, *noticeID), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	response := NoticeResponse{}
+	err = json.Unmarshal(*body, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.Notice, nil
+}
+
+// DeleteNotice - Delete a notice in the status page.
+func (c *Client) DeleteNotice(ctx context.Context, statusPageSubdomain *string, noticeID *string) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/status_pages/%s/notices/%s", c.HostURL, *statusPageSubdomain, *noticeID), nil)
+	if err != nil {
+		return err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	convertedBody := string(*body)
+	if convertedBody != `""` {
+		return errors.New(convertedBody)
+	}
+
+	return nil
+}
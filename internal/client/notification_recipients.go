@@ -0,0 +1,134 @@
+package statuspal
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+type notificationRecipientsResponse struct {
+	NotificationRecipients []NotificationRecipient `json:"notification_recipients"`
+	Links                  *Links                  `json:"links"`
+}
+
+type NotificationRecipientResponse struct {
+	NotificationRecipient NotificationRecipient `json:"notification_recipient"`
+}
+
+// GetNotificationRecipients - Returns list of notification recipients from the status page, following all the pages.
+func (c *Client) GetNotificationRecipients(ctx context.Context, statusPageSubdomain *string) (*[]NotificationRecipient, error) {
+	recipients := []NotificationRecipient{}
+	if err := c.ListNotificationRecipients(ctx, statusPageSubdomain, collectAll(&recipients)); err != nil {
+		return nil, err
+	}
+
+	return &recipients, nil
+}
+
+// ListNotificationRecipients - Calls fn with each page of notification recipients from the status page.
+func (c *Client) ListNotificationRecipients(ctx context.Context, statusPageSubdomain *string, fn PageFunc[NotificationRecipient]) error {
+	return paginate(ctx, c, fmt.Sprintf("%s/status_pages/%s/notification_recipients", c.HostURL, *statusPageSubdomain), func(body []byte) ([]NotificationRecipient, *Links, error) {
+		response := notificationRecipientsResponse{}
+		if err := json.Unmarshal(body, &response); err != nil {
+			return nil, nil, err
+		}
+
+		return response.NotificationRecipients, response.Links, nil
+	}, fn)
+}
+
+// GetNotificationRecipient - Returns specific notification recipient from the status page.
+func (c *Client) GetNotificationRecipient(ctx context.Context, statusPageSubdomain *string, recipientID *string) (*NotificationRecipient, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/status_pages/%s/notification_recipients/%s", c.HostURL, *statusPageSubdomain, *recipientID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	response := NotificationRecipientResponse{}
+	err = json.Unmarshal(*body, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.NotificationRecipient, nil
+}
+
+// CreateNotificationRecipient - Create new notification recipient in the status page.
+func (c *Client) CreateNotificationRecipient(ctx context.Context, recipient *NotificationRecipient, statusPageSubdomain *string) (*NotificationRecipient, error) {
+	rb, err := json.Marshal(NotificationRecipientResponse{NotificationRecipient: *recipient})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/status_pages/%s/notification_recipients", c.HostURL, *statusPageSubdomain), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	response := NotificationRecipientResponse{}
+	err = json.Unmarshal(*body, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.NotificationRecipient, nil
+}
+
+// UpdateNotificationRecipient - Update a notification recipient in the status page.
+func (c *Client) UpdateNotificationRecipient(ctx context.Context, recipient *NotificationRecipient, statusPageSubdomain *string, recipientID *string) (*NotificationRecipient, error) {
+	rb, err := json.Marshal(NotificationRecipientResponse{NotificationRecipient: *recipient})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/status_pages/%s/notification_recipients/%s", c.HostURL, *statusPageSubdomain, *recipientID), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	response := NotificationRecipientResponse{}
+	err = json.Unmarshal(*body, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.NotificationRecipient, nil
+}
+
+// DeleteNotificationRecipient - Delete a notification recipient in the status page.
+func (c *Client) DeleteNotificationRecipient(ctx context.Context, statusPageSubdomain *string, recipientID *string) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/status_pages/%s/notification_recipients/%s", c.HostURL, *statusPageSubdomain, *recipientID), nil)
+	if err != nil {
+		return err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	convertedBody := string(*body)
+	if convertedBody != `""` {
+		return errors.New(convertedBody)
+	}
+
+	return nil
+}
@@ -0,0 +1,106 @@
+package statuspal
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Links holds the pagination links of a list response.
+type Links struct {
+	Prev *string `json:"prev"`
+	Next *string `json:"next"`
+}
+
+// PageFunc is called with each page of a list endpoint, in order.
+// Returning false stops the pagination, e.g. once enough items were collected.
+type PageFunc[T any] func(page []T) bool
+
+// paginate requests the pageURL, then follows the `links.next` URL of each
+// response until the last page is reached or fn returns false.
+func paginate[T any](ctx context.Context, c *Client, pageURL string, decode func(body []byte) ([]T, *Links, error), fn PageFunc[T]) error {
+	visited := map[string]bool{}
+
+	for pageURL != "" {
+		// A server returning a page already visited would make us loop forever.
+		if visited[pageURL] {
+			return fmt.Errorf("pagination loop detected, the page %s was already requested", pageURL)
+		}
+		visited[pageURL] = true
+
+		req, err := http.NewRequestWithContext(ctx, "GET", pageURL, nil)
+		if err != nil {
+			return err
+		}
+
+		body, err := c.doRequest(req)
+		if err != nil {
+			return err
+		}
+
+		items, links, err := decode(*body)
+		if err != nil {
+			return err
+		}
+
+		if !fn(items) {
+			return nil
+		}
+
+		pageURL, err = nextPageURL(pageURL, links)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// nextPageURL resolves the URL of the page following currentURL, or returns
+// an empty string on the last page. The next link may be an absolute URL, a
+// URL relative to the current page, or a bare `after` cursor.
+//
+// The API key is sent with every request, so a next link to another scheme or
+// host than the current page is rejected instead of leaking the key to it.
+func nextPageURL(currentURL string, links *Links) (string, error) {
+	if links == nil || links.Next == nil || *links.Next == "" {
+		return "", nil
+	}
+
+	current, err := url.Parse(currentURL)
+	if err != nil {
+		return "", err
+	}
+
+	next := *links.Next
+	if !strings.ContainsAny(next, "/?=") {
+		query := current.Query()
+		query.Del("before")
+		query.Set("after", next)
+		current.RawQuery = query.Encode()
+
+		return current.String(), nil
+	}
+
+	ref, err := url.Parse(next)
+	if err != nil {
+		return "", fmt.Errorf("invalid next page link %q: %w", next, err)
+	}
+
+	nextURL := current.ResolveReference(ref)
+	if nextURL.Scheme != current.Scheme || !strings.EqualFold(nextURL.Host, current.Host) {
+		return "", fmt.Errorf("next page link %q doesn't point to %s://%s", next, current.Scheme, current.Host)
+	}
+
+	return nextURL.String(), nil
+}
+
+// collectAll returns a PageFunc appending every page to items.
+func collectAll[T any](items *[]T) PageFunc[T] {
+	return func(page []T) bool {
+		*items = append(*items, page...)
+		return true
+	}
+}
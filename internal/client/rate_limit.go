@@ -0,0 +1,98 @@
+package statuspal
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// RateLimit defines the default limit of requests per second.
+const RateLimit = 10
+
+// BurstLimit defines the default value of request that can be bursted.
+const BurstLimit = 10
+
+// SetRateLimit configures the limit of requests per second, with a burst, sent by the client.
+//
+// The limit can still be lowered at runtime when the API reports, through its rate limit headers, that fewer
+// requests are left, but it is never raised above the configured one.
+func (c *Client) SetRateLimit(requestsPerSecond float64, burst int) {
+	c.requestsPerSecond = rate.Limit(requestsPerSecond)
+	c.burst = burst
+
+	if c.rateLimiter == nil {
+		c.rateLimiter = rate.NewLimiter(c.requestsPerSecond, c.burst)
+		return
+	}
+
+	c.rateLimiter.SetLimit(c.requestsPerSecond)
+	c.rateLimiter.SetBurst(c.burst)
+}
+
+// waitRateLimit blocks until the rate limiter allows another request. A client without a limiter is not limited.
+func (c *Client) waitRateLimit(ctx context.Context) error {
+	if c.rateLimiter == nil {
+		return nil
+	}
+
+	if err := c.rateLimiter.Wait(ctx); err != nil {
+		return fmt.Errorf("failed to wait the time required by rate limiter: %w", err)
+	}
+
+	return nil
+}
+
+// adaptRateLimit adjusts the rate limiter to the remaining requests reported by the API.
+//
+// It reads the X-RateLimit-Remaining and X-RateLimit-Reset headers, the latter being either the seconds left
+// until the window resets or its Unix timestamp, and slows the client down so the remaining requests are spread
+// until the reset. The configured limit is restored as soon as the API allows it again.
+func (c *Client) adaptRateLimit(header http.Header) {
+	if c.rateLimiter == nil {
+		return
+	}
+
+	remaining, err := strconv.ParseInt(header.Get("X-RateLimit-Remaining"), 10, 64)
+	if err != nil || remaining < 0 {
+		return
+	}
+
+	reset, err := strconv.ParseInt(header.Get("X-RateLimit-Reset"), 10, 64)
+	if err != nil || reset < 0 {
+		return
+	}
+
+	untilReset := time.Duration(reset) * time.Second
+	// Values larger than a day can only be a Unix timestamp.
+	if untilReset > 24*time.Hour {
+		untilReset = time.Until(time.Unix(reset, 0))
+	}
+	if untilReset <= 0 {
+		c.rateLimiter.SetLimit(c.requestsPerSecond)
+		c.rateLimiter.SetBurst(c.burst)
+		return
+	}
+
+	limit := rate.Limit(float64(remaining) / untilReset.Seconds())
+	if remaining == 0 {
+		// Nothing left in the current window, let the next request through only once it resets.
+		limit = rate.Every(untilReset)
+	}
+
+	burst := c.burst
+	if remaining < int64(burst) {
+		burst = max(int(remaining), 1)
+	}
+
+	if limit >= c.requestsPerSecond {
+		limit = c.requestsPerSecond
+		burst = c.burst
+	}
+
+	c.rateLimiter.SetLimit(limit)
+	c.rateLimiter.SetBurst(burst)
+}
@@ -0,0 +1,106 @@
+package statuspal
+
+import (
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// isIdempotent reports whether a request with the given method can be safely sent more than once.
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+
+	return false
+}
+
+// shouldRetry decides if a request has to be sent again based on the response, or the error, of the last attempt.
+//
+// Connection errors and 5xx responses, 503 included, are only retried for idempotent methods because the server
+// may have already processed the request. A 429 response means the request was rejected by the rate limit before
+// being processed, so it is retried for every method.
+func shouldRetry(req *http.Request, res *http.Response, err error) bool {
+	// Never retry once the request context is done, e.g. Terraform was interrupted.
+	if req.Context().Err() != nil {
+		return false
+	}
+
+	// The body can't be sent again if it can't be rewound.
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return false
+	}
+
+	if err != nil {
+		return isIdempotent(req.Method)
+	}
+
+	switch {
+	case res.StatusCode == http.StatusTooManyRequests:
+		return true
+	case res.StatusCode >= http.StatusInternalServerError && res.StatusCode != http.StatusNotImplemented:
+		return isIdempotent(req.Method)
+	}
+
+	return false
+}
+
+// backoff returns how long to wait before the next attempt.
+//
+// The Retry-After header is honored when the server sends one, up to RetryWaitMax so that a misbehaving server
+// can't hold the operation for hours, otherwise an exponential backoff with jitter bounded by RetryWaitMin and
+// RetryWaitMax is used.
+func (c *Client) backoff(attempt int, res *http.Response) time.Duration {
+	waitMin, waitMax := c.RetryWaitMin, c.RetryWaitMax
+	if waitMax < waitMin {
+		waitMax = waitMin
+	}
+
+	if res != nil {
+		if wait, ok := parseRetryAfter(res.Header.Get("Retry-After")); ok {
+			return min(wait, waitMax)
+		}
+	}
+
+	wait := time.Duration(float64(waitMin) * math.Pow(2, float64(attempt)))
+	if wait <= 0 || wait > waitMax {
+		wait = waitMax
+	}
+
+	// Spread the retries of concurrent requests by waiting a random time between the half and the full backoff.
+	half := wait / 2
+	if half <= 0 {
+		return wait
+	}
+
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+// parseRetryAfter parses the value of a Retry-After header, which can be either a number of seconds or an HTTP date.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.ParseInt(value, 10, 64); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		wait := time.Until(date)
+		if wait < 0 {
+			wait = 0
+		}
+
+		return wait, true
+	}
+
+	return 0, false
+}
@@ -1,45 +1,77 @@
 package statuspal
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
+	"strconv"
 	"strings"
 )
 
 type servicesResponse struct {
 	Services []Service `json:"services"`
+	Links    *Links    `json:"links"`
 }
 
 type ServiceResponse struct {
 	Service Service `json:"service"`
 }
 
-// GetService - Returns list of services from the status page.
-func (c *Client) GetServices(statusPageSubdomain *string) (*[]Service, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/status_pages/%s/services", c.HostURL, *statusPageSubdomain), nil)
-	if err != nil {
+// ServicesQuery filters the services listed by the API, the unset fields don't filter.
+type ServicesQuery struct {
+	Monitoring *string `query:"monitoring"`
+	IsUp       *bool   `query:"is_up"`
+	Private    *bool   `query:"private"`
+	ParentID   *string `query:"parent_id"`
+}
+
+// GetServices - Returns list of services from the status page, following all the pages.
+func (c *Client) GetServices(ctx context.Context, statusPageSubdomain *string) (*[]Service, error) {
+	services := []Service{}
+	if err := c.ListServices(ctx, statusPageSubdomain, ServicesQuery{}, collectAll(&services)); err != nil {
 		return nil, err
 	}
 
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
+	return &services, nil
+}
+
+// ListServices - Calls fn with each page of services from the status page matching the query.
+func (c *Client) ListServices(ctx context.Context, statusPageSubdomain *string, query ServicesQuery, fn PageFunc[Service]) error {
+	urlParams := url.Values{}
+	if query.Monitoring != nil {
+		urlParams.Add("monitoring", *query.Monitoring)
+	}
+	if query.IsUp != nil {
+		urlParams.Add("is_up", strconv.FormatBool(*query.IsUp))
+	}
+	if query.Private != nil {
+		urlParams.Add("private", strconv.FormatBool(*query.Private))
+	}
+	if query.ParentID != nil {
+		urlParams.Add("parent_id", *query.ParentID)
 	}
 
-	response := servicesResponse{}
-	err = json.Unmarshal(*body, &response)
-	if err != nil {
-		return nil, err
+	pageURL := fmt.Sprintf("%s/status_pages/%s/services", c.HostURL, *statusPageSubdomain)
+	if len(urlParams) > 0 {
+		pageURL += "?" + urlParams.Encode()
 	}
 
-	return &response.Services, nil
+	return paginate(ctx, c, pageURL, func(body []byte) ([]Service, *Links, error) {
+		response := servicesResponse{}
+		if err := json.Unmarshal(body, &response); err != nil {
+			return nil, nil, err
+		}
+
+		return response.Services, response.Links, nil
+	}, fn)
 }
 
 // GetService - Returns specific service from the organization.
-func (c *Client) GetService(statusPageSubdomain *string, serviceID *string) (*Service, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/status_pages/%s/services/%s", c.HostURL, *statusPageSubdomain, *serviceID), nil)
+func (c *Client) GetService(ctx context.Context, statusPageSubdomain *string, serviceID *string) (*Service, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/status_pages/%s/services/%s", c.HostURL, *statusPageSubdomain, *serviceID), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -59,13 +91,13 @@ func (c *Client) GetService(statusPageSubdomain *string, serviceID *string) (*Se
 }
 
 // CreateService - Create new service in the organization.
-func (c *Client) CreateService(service *Service, statusPageSubdomain *string) (*Service, error) {
+func (c *Client) CreateService(ctx context.Context, service *Service, statusPageSubdomain *string) (*Service, error) {
 	rb, err := json.Marshal(ServiceResponse{Service: *service})
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/status_pages/%s/services", c.HostURL, *statusPageSubdomain), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/status_pages/%s/services", c.HostURL, *statusPageSubdomain), strings.NewReader(string(rb)))
 	if err != nil {
 		return nil, err
 	}
@@ -85,13 +117,13 @@ func (c *Client) CreateService(service *Service, statusPageSubdomain *string) (*
 }
 
 // UpdateService - Update a service in the organization.
-func (c *Client) UpdateService(service *Service, statusPageSubdomain *string, serviceID *string) (*Service, error) {
+func (c *Client) UpdateService(ctx context.Context, service *Service, statusPageSubdomain *string, serviceID *string) (*Service, error) {
 	rb, err := json.Marshal(ServiceResponse{Service: *service})
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/status_pages/%s/services/%s", c.HostURL, *statusPageSubdomain, *serviceID), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/status_pages/%s/services/%s", c.HostURL, *statusPageSubdomain, *serviceID), strings.NewReader(string(rb)))
 	if err != nil {
 		return nil, err
 	}
@@ -111,8 +143,8 @@ func (c *Client) UpdateService(service *Service, statusPageSubdomain *string, se
 }
 
 // DeleteService - Delete a service in the organization.
-func (c *Client) DeleteService(statusPageSubdomain *string, serviceID *string) error {
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/status_pages/%s/services/%s", c.HostURL, *statusPageSubdomain, *serviceID), nil)
+func (c *Client) DeleteService(ctx context.Context, statusPageSubdomain *string, serviceID *string) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/status_pages/%s/services/%s", c.HostURL, *statusPageSubdomain, *serviceID), nil)
 	if err != nil {
 		return err
 	}
@@ -1,6 +1,7 @@
 package statuspal
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -10,36 +11,38 @@ import (
 
 type statusPagesResponse struct {
 	StatusPages []StatusPage `json:"status_pages"`
+	Links       *Links       `json:"links"`
 }
 
 type statusPageResponse struct {
 	StatusPage StatusPage `json:"status_page"`
 }
 
-// GetStatusPages - Returns list of status pages from the organization.
-func (c *Client) GetStatusPages(organizationID *string) (*[]StatusPage, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/orgs/%s/status_pages", c.HostURL, *organizationID), nil)
-	if err != nil {
+// GetStatusPages - Returns list of status pages from the organization, following all the pages.
+func (c *Client) GetStatusPages(ctx context.Context, organizationID *string) (*[]StatusPage, error) {
+	statusPages := []StatusPage{}
+	if err := c.ListStatusPages(ctx, organizationID, collectAll(&statusPages)); err != nil {
 		return nil, err
 	}
 
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
+	return &statusPages, nil
+}
 
-	response := statusPagesResponse{}
-	err = json.Unmarshal(*body, &response)
-	if err != nil {
-		return nil, err
-	}
+// ListStatusPages - Calls fn with each page of status pages from the organization.
+func (c *Client) ListStatusPages(ctx context.Context, organizationID *string, fn PageFunc[StatusPage]) error {
+	return paginate(ctx, c, fmt.Sprintf("%s/orgs/%s/status_pages", c.HostURL, *organizationID), func(body []byte) ([]StatusPage, *Links, error) {
+		response := statusPagesResponse{}
+		if err := json.Unmarshal(body, &response); err != nil {
+			return nil, nil, err
+		}
 
-	return &response.StatusPages, nil
+		return response.StatusPages, response.Links, nil
+	}, fn)
 }
 
 // GetStatusPage - Returns specific status page from the organization.
-func (c *Client) GetStatusPage(organizationID *string, statusPageSubdomain *string) (*StatusPage, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/orgs/%s/status_pages/%s", c.HostURL, *organizationID, *statusPageSubdomain), nil)
+func (c *Client) GetStatusPage(ctx context.Context, organizationID *string, statusPageSubdomain *string) (*StatusPage, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/orgs/%s/status_pages/%s", c.HostURL, *organizationID, *statusPageSubdomain), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -59,13 +62,13 @@ func (c *Client) GetStatusPage(organizationID *string, statusPageSubdomain *stri
 }
 
 // CreateStatusPage - Create new status page in the organization.
-func (c *Client) CreateStatusPage(statusPage *StatusPage, organizationID *string) (*StatusPage, error) {
+func (c *Client) CreateStatusPage(ctx context.Context, statusPage *StatusPage, organizationID *string) (*StatusPage, error) {
 	rb, err := json.Marshal(statusPageResponse{StatusPage: *statusPage})
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/orgs/%s/status_pages", c.HostURL, *organizationID), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/orgs/%s/status_pages", c.HostURL, *organizationID), strings.NewReader(string(rb)))
 	if err != nil {
 		return nil, err
 	}
@@ -85,13 +88,13 @@ func (c *Client) CreateStatusPage(statusPage *StatusPage, organizationID *string
 }
 
 // UpdateStatusPage - Update a status page in the organization.
-func (c *Client) UpdateStatusPage(statusPage *StatusPage, organizationID *string, statusPageSubdomain *string) (*StatusPage, error) {
+func (c *Client) UpdateStatusPage(ctx context.Context, statusPage *StatusPage, organizationID *string, statusPageSubdomain *string) (*StatusPage, error) {
 	rb, err := json.Marshal(statusPageResponse{StatusPage: *statusPage})
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/orgs/%s/status_pages/%s", c.HostURL, *organizationID, *statusPageSubdomain), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/orgs/%s/status_pages/%s", c.HostURL, *organizationID, *statusPageSubdomain), strings.NewReader(string(rb)))
 	if err != nil {
 		return nil, err
 	}
@@ -111,8 +114,8 @@ func (c *Client) UpdateStatusPage(statusPage *StatusPage, organizationID *string
 }
 
 // DeleteStatusPage - Delete a status page in the organization.
-func (c *Client) DeleteStatusPage(organizationID *string, statusPageSubdomain *string) error {
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/orgs/%s/status_pages/%s", c.HostURL, *organizationID, *statusPageSubdomain), nil)
+func (c *Client) DeleteStatusPage(ctx context.Context, organizationID *string, statusPageSubdomain *string) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/orgs/%s/status_pages/%s", c.HostURL, *organizationID, *statusPageSubdomain), nil)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,215 @@
+package statuspal
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// SubscribersBatchSize defines the maximum number of subscribers sent in a single bulk request.
+const SubscribersBatchSize = 100
+
+type subscribersResponse struct {
+	Subscribers []Subscriber `json:"subscribers"`
+	Links       *Links       `json:"links,omitempty"`
+}
+
+type SubscriberResponse struct {
+	Subscriber Subscriber `json:"subscriber"`
+}
+
+// deleteSubscribersRequest is the request body deleting a batch of subscribers.
+type deleteSubscribersRequest struct {
+	IDs []int64 `json:"ids"`
+}
+
+// GetSubscribers - Returns list of subscribers from the status page, following all the pages.
+func (c *Client) GetSubscribers(ctx context.Context, statusPageSubdomain *string) (*[]Subscriber, error) {
+	subscribers := []Subscriber{}
+	if err := c.ListSubscribers(ctx, statusPageSubdomain, collectAll(&subscribers)); err != nil {
+		return nil, err
+	}
+
+	return &subscribers, nil
+}
+
+// ListSubscribers - Calls fn with each page of subscribers from the status page.
+func (c *Client) ListSubscribers(ctx context.Context, statusPageSubdomain *string, fn PageFunc[Subscriber]) error {
+	return paginate(ctx, c, fmt.Sprintf("%s/status_pages/%s/subscribers", c.HostURL, *statusPageSubdomain), func(body []byte) ([]Subscriber, *Links, error) {
+		response := subscribersResponse{}
+		if err := json.Unmarshal(body, &response); err != nil {
+			return nil, nil, err
+		}
+
+		return response.Subscribers, response.Links, nil
+	}, fn)
+}
+
+// GetSubscriber - Returns specific subscriber from the status page.
+func (c *Client) GetSubscriber(ctx context.Context, statusPageSubdomain *string, subscriberID *string) (*Subscriber, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/status_pages/%s/subscribers/%s", c.HostURL, *statusPageSubdomain, *subscriberID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	response := SubscriberResponse{}
+	err = json.Unmarshal(*body, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.Subscriber, nil
+}
+
+// CreateSubscriber - Create new subscriber in the status page.
+func (c *Client) CreateSubscriber(ctx context.Context, subscriber *Subscriber, statusPageSubdomain *string) (*Subscriber, error) {
+	rb, err := json.Marshal(SubscriberResponse{Subscriber: *subscriber})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/status_pages/%s/subscribers", c.HostURL, *statusPageSubdomain), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	response := SubscriberResponse{}
+	err = json.Unmarshal(*body, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.Subscriber, nil
+}
+
+// UpdateSubscriber - Update a subscriber in the status page.
+func (c *Client) UpdateSubscriber(ctx context.Context, subscriber *Subscriber, statusPageSubdomain *string, subscriberID *string) (*Subscriber, error) {
+	rb, err := json.Marshal(SubscriberResponse{Subscriber: *subscriber})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/status_pages/%s/subscribers/%s", c.HostURL, *statusPageSubdomain, *subscriberID), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	response := SubscriberResponse{}
+	err = json.Unmarshal(*body, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.Subscriber, nil
+}
+
+// DeleteSubscriber - Delete a subscriber in the status page.
+func (c *Client) DeleteSubscriber(ctx context.Context, statusPageSubdomain *string, subscriberID *string) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/status_pages/%s/subscribers/%s", c.HostURL, *statusPageSubdomain, *subscriberID), nil)
+	if err != nil {
+		return err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	convertedBody := string(*body)
+	if convertedBody != `""` {
+		return errors.New(convertedBody)
+	}
+
+	return nil
+}
+
+// CreateSubscribers - Create new subscribers in the status page, sending them in batches of SubscribersBatchSize.
+//
+// Each batch is a request subject to the client rate limit and retries. On error, the subscribers created by the
+// previous batches are returned with it.
+func (c *Client) CreateSubscribers(ctx context.Context, subscribers []Subscriber, statusPageSubdomain *string) ([]Subscriber, error) {
+	created := make([]Subscriber, 0, len(subscribers))
+
+	for start := 0; start < len(subscribers); start += SubscribersBatchSize {
+		end := min(start+SubscribersBatchSize, len(subscribers))
+
+		rb, err := json.Marshal(subscribersResponse{Subscribers: subscribers[start:end]})
+		if err != nil {
+			return created, err
+		}
+
+		req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/status_pages/%s/subscribers/bulk", c.HostURL, *statusPageSubdomain), strings.NewReader(string(rb)))
+		if err != nil {
+			return created, err
+		}
+
+		body, err := c.doRequest(req)
+		if err != nil {
+			return created, err
+		}
+
+		response := subscribersResponse{}
+		err = json.Unmarshal(*body, &response)
+		if err != nil {
+			return created, err
+		}
+
+		created = append(created, response.Subscribers...)
+	}
+
+	return created, nil
+}
+
+// DeleteSubscribers - Delete subscribers in the status page, sending their IDs in batches of SubscribersBatchSize.
+//
+// Each batch is a request subject to the client rate limit and retries. On error, the IDs of the subscribers
+// deleted by the previous batches are returned with it.
+func (c *Client) DeleteSubscribers(ctx context.Context, statusPageSubdomain *string, subscriberIDs []int64) ([]int64, error) {
+	deleted := make([]int64, 0, len(subscriberIDs))
+
+	for start := 0; start < len(subscriberIDs); start += SubscribersBatchSize {
+		end := min(start+SubscribersBatchSize, len(subscriberIDs))
+
+		rb, err := json.Marshal(deleteSubscribersRequest{IDs: subscriberIDs[start:end]})
+		if err != nil {
+			return deleted, err
+		}
+
+		req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/status_pages/%s/subscribers/bulk_delete", c.HostURL, *statusPageSubdomain), strings.NewReader(string(rb)))
+		if err != nil {
+			return deleted, err
+		}
+
+		body, err := c.doRequest(req)
+		if err != nil {
+			return deleted, err
+		}
+
+		convertedBody := string(*body)
+		if convertedBody != `""` {
+			return deleted, errors.New(convertedBody)
+		}
+
+		deleted = append(deleted, subscriberIDs[start:end]...)
+	}
+
+	return deleted, nil
+}
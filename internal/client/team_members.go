@@ -0,0 +1,134 @@
+package statuspal
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+type teamMembersResponse struct {
+	TeamMembers []TeamMember `json:"members"`
+	Links       *Links       `json:"links"`
+}
+
+type TeamMemberResponse struct {
+	TeamMember TeamMember `json:"member"`
+}
+
+// GetTeamMembers - Returns list of team members from the organization, following all the pages.
+func (c *Client) GetTeamMembers(ctx context.Context, organizationID *string) (*[]TeamMember, error) {
+	members := []TeamMember{}
+	if err := c.ListTeamMembers(ctx, organizationID, collectAll(&members)); err != nil {
+		return nil, err
+	}
+
+	return &members, nil
+}
+
+// ListTeamMembers - Calls fn with each page of team members from the organization.
+func (c *Client) ListTeamMembers(ctx context.Context, organizationID *string, fn PageFunc[TeamMember]) error {
+	return paginate(ctx, c, fmt.Sprintf("%s/orgs/%s/members", c.HostURL, *organizationID), func(body []byte) ([]TeamMember, *Links, error) {
+		response := teamMembersResponse{}
+		if err := json.Unmarshal(body, &response); err != nil {
+			return nil, nil, err
+		}
+
+		return response.TeamMembers, response.Links, nil
+	}, fn)
+}
+
+// GetTeamMember - Returns specific team member from the organization.
+func (c *Client) GetTeamMember(ctx context.Context, organizationID *string, memberID *string) (*TeamMember, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/orgs/%s/members/%s", c.HostURL, *organizationID, *memberID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	response := TeamMemberResponse{}
+	err = json.Unmarshal(*body, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.TeamMember, nil
+}
+
+// CreateTeamMember - Invite new team member in the organization, by email.
+func (c *Client) CreateTeamMember(ctx context.Context, member *TeamMember, organizationID *string) (*TeamMember, error) {
+	rb, err := json.Marshal(TeamMemberResponse{TeamMember: *member})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/orgs/%s/members", c.HostURL, *organizationID), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	response := TeamMemberResponse{}
+	err = json.Unmarshal(*body, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.TeamMember, nil
+}
+
+// UpdateTeamMember - Update a team member in the organization.
+func (c *Client) UpdateTeamMember(ctx context.Context, member *TeamMember, organizationID *string, memberID *string) (*TeamMember, error) {
+	rb, err := json.Marshal(TeamMemberResponse{TeamMember: *member})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/orgs/%s/members/%s", c.HostURL, *organizationID, *memberID), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	response := TeamMemberResponse{}
+	err = json.Unmarshal(*body, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.TeamMember, nil
+}
+
+// DeleteTeamMember - Remove a team member from the organization, or revoke its pending invitation.
+func (c *Client) DeleteTeamMember(ctx context.Context, organizationID *string, memberID *string) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/orgs/%s/members/%s", c.HostURL, *organizationID, *memberID), nil)
+	if err != nil {
+		return err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	convertedBody := string(*body)
+	if convertedBody != `""` {
+		return errors.New(convertedBody)
+	}
+
+	return nil
+}
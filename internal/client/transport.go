@@ -0,0 +1,67 @@
+package statuspal
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// TransportConfig defines how the client reaches the StatusPal API.
+type TransportConfig struct {
+	// ProxyURL is the URL of the HTTP proxy used for every request.
+	// When empty, the proxy is read from the HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables.
+	ProxyURL string
+	// CABundle is a PEM encoded bundle of CA certificates trusted in addition to the system ones.
+	CABundle string
+	// InsecureSkipVerify disables the verification of the API TLS certificate. Only meant for testing.
+	InsecureSkipVerify bool
+}
+
+// ConfigureTransport replaces the HTTP transport of the client with one built from the given configuration.
+func (c *Client) ConfigureTransport(config TransportConfig) error {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	if config.ProxyURL != "" {
+		proxyURL, err := url.Parse(config.ProxyURL)
+		if err != nil {
+			return fmt.Errorf("invalid proxy URL: %w", err)
+		}
+		if proxyURL.Scheme == "" || proxyURL.Host == "" {
+			return fmt.Errorf("invalid proxy URL %q: the scheme and host are required", config.ProxyURL)
+		}
+
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	if config.CABundle != "" || config.InsecureSkipVerify {
+		tlsConfig := &tls.Config{
+			MinVersion:         tls.VersionTLS12,
+			InsecureSkipVerify: config.InsecureSkipVerify,
+		}
+
+		if config.CABundle != "" {
+			pool, err := x509.SystemCertPool()
+			if err != nil || pool == nil {
+				pool = x509.NewCertPool()
+			}
+
+			if !pool.AppendCertsFromPEM([]byte(config.CABundle)) {
+				return errors.New("invalid CA bundle: no PEM encoded certificate found")
+			}
+
+			tlsConfig.RootCAs = pool
+		}
+
+		transport.TLSClientConfig = tlsConfig
+	}
+
+	if c.HTTPClient == nil {
+		c.HTTPClient = &http.Client{}
+	}
+	c.HTTPClient.Transport = transport
+
+	return nil
+}
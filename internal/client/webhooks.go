@@ -0,0 +1,134 @@
+package statuspal
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+type webhooksResponse struct {
+	Webhooks []Webhook `json:"webhooks"`
+	Links    *Links    `json:"links"`
+}
+
+type WebhookResponse struct {
+	Webhook Webhook `json:"webhook"`
+}
+
+// GetWebhooks - Returns list of webhooks from the status page, following all the pages.
+func (c *Client) GetWebhooks(ctx context.Context, statusPageSubdomain *string) (*[]Webhook, error) {
+	webhooks := []Webhook{}
+	if err := c.ListWebhooks(ctx, statusPageSubdomain, collectAll(&webhooks)); err != nil {
+		return nil, err
+	}
+
+	return &webhooks, nil
+}
+
+// ListWebhooks - Calls fn with each page of webhooks from the status page.
+func (c *Client) ListWebhooks(ctx context.Context, statusPageSubdomain *string, fn PageFunc[Webhook]) error {
+	return paginate(ctx, c, fmt.Sprintf("%s/status_pages/%s/webhooks", c.HostURL, *statusPageSubdomain), func(body []byte) ([]Webhook, *Links, error) {
+		response := webhooksResponse{}
+		if err := json.Unmarshal(body, &response); err != nil {
+			return nil, nil, err
+		}
+
+		return response.Webhooks, response.Links, nil
+	}, fn)
+}
+
+// GetWebhook - Returns specific webhook from the status page.
+func (c *Client) GetWebhook(ctx context.Context, statusPageSubdomain *string, webhookID *string) (*Webhook, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/status_pages/%s/webhooks/%s", c.HostURL, *statusPageSubdomain, *webhookID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	response := WebhookResponse{}
+	err = json.Unmarshal(*body, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.Webhook, nil
+}
+
+// CreateWebhook - Create new webhook in the status page.
+func (c *Client) CreateWebhook(ctx context.Context, webhook *Webhook, statusPageSubdomain *string) (*Webhook, error) {
+	rb, err := json.Marshal(WebhookResponse{Webhook: *webhook})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/status_pages/%s/webhooks", c.HostURL, *statusPageSubdomain), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	response := WebhookResponse{}
+	err = json.Unmarshal(*body, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.Webhook, nil
+}
+
+// UpdateWebhook - Update a webhook in the status page.
+func (c *Client) UpdateWebhook(ctx context.Context, webhook *Webhook, statusPageSubdomain *string, webhookID *string) (*Webhook, error) {
+	rb, err := json.Marshal(WebhookResponse{Webhook: *webhook})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/status_pages/%s/webhooks/%s", c.HostURL, *statusPageSubdomain, *webhookID), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	response := WebhookResponse{}
+	err = json.Unmarshal(*body, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.Webhook, nil
+}
+
+// DeleteWebhook - Delete a webhook in the status page.
+func (c *Client) DeleteWebhook(ctx context.Context, statusPageSubdomain *string, webhookID *string) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/status_pages/%s/webhooks/%s", c.HostURL, *statusPageSubdomain, *webhookID), nil)
+	if err != nil {
+		return err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	convertedBody := string(*body)
+	if convertedBody != `""` {
+		return errors.New(convertedBody)
+	}
+
+	return nil
+}
@@ -0,0 +1,470 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	statuspal "terraform-provider-statuspal/internal/client"
+)
+
+// chatPlatform describes a chat platform the status page can post its updates to.
+type chatPlatform struct {
+	// name is the platform name in the API and in the resource type name.
+	name string
+	// title is the human readable name of the platform.
+	title string
+	// flag is the status page attribute enabling the platform notifications.
+	flag string
+	// enabled reports whether the platform notifications are enabled on the status page.
+	enabled func(statusPage *statuspal.StatusPage) bool
+}
+
+var (
+	slackChatPlatform = chatPlatform{
+		name:    "slack",
+		title:   "Slack",
+		flag:    "slack_subscriptions_enabled",
+		enabled: func(statusPage *statuspal.StatusPage) bool { return statusPage.SlackSubscriptionsEnabled },
+	}
+	teamsChatPlatform = chatPlatform{
+		name:    "teams",
+		title:   "Microsoft Teams",
+		flag:    "teams_notifications_enabled",
+		enabled: func(statusPage *statuspal.StatusPage) bool { return statusPage.TeamsNotificationsEnabled },
+	}
+	discordChatPlatform = chatPlatform{
+		name:    "discord",
+		title:   "Discord",
+		flag:    "discord_notifications_enabled",
+		enabled: func(statusPage *statuspal.StatusPage) bool { return statusPage.DiscordNotificationsEnabled },
+	}
+	googleChatChatPlatform = chatPlatform{
+		name:    "google_chat",
+		title:   "Google Chat",
+		flag:    "google_chat_notifications_enabled",
+		enabled: func(statusPage *statuspal.StatusPage) bool { return statusPage.GoogleChatNotificationsEnabled },
+	}
+	mattermostChatPlatform = chatPlatform{
+		name:    "mattermost",
+		title:   "Mattermost",
+		flag:    "mattermost_notifications_enabled",
+		enabled: func(statusPage *statuspal.StatusPage) bool { return statusPage.MattermostNotificationsEnabled },
+	}
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &chatIntegrationResource{}
+	_ resource.ResourceWithConfigure      = &chatIntegrationResource{}
+	_ resource.ResourceWithImportState    = &chatIntegrationResource{}
+	_ resource.ResourceWithValidateConfig = &chatIntegrationResource{}
+	_ resource.ResourceWithModifyPlan     = &chatIntegrationResource{}
+)
+
+// NewSlackIntegrationResource is a helper function to simplify the provider implementation.
+func NewSlackIntegrationResource() resource.Resource {
+	return &chatIntegrationResource{platform: slackChatPlatform}
+}
+
+// NewTeamsIntegrationResource is a helper function to simplify the provider implementation.
+func NewTeamsIntegrationResource() resource.Resource {
+	return &chatIntegrationResource{platform: teamsChatPlatform}
+}
+
+// NewDiscordIntegrationResource is a helper function to simplify the provider implementation.
+func NewDiscordIntegrationResource() resource.Resource {
+	return &chatIntegrationResource{platform: discordChatPlatform}
+}
+
+// NewGoogleChatIntegrationResource is a helper function to simplify the provider implementation.
+func NewGoogleChatIntegrationResource() resource.Resource {
+	return &chatIntegrationResource{platform: googleChatChatPlatform}
+}
+
+// NewMattermostIntegrationResource is a helper function to simplify the provider implementation.
+func NewMattermostIntegrationResource() resource.Resource {
+	return &chatIntegrationResource{platform: mattermostChatPlatform}
+}
+
+// chatIntegrationResource is the resource implementation, shared by all the chat platforms.
+type chatIntegrationResource struct {
+	client   *statuspal.Client
+	platform chatPlatform
+}
+
+// chatIntegrationResourceModel maps the resource schema data.
+type chatIntegrationResourceModel struct {
+	ID                  types.String         `tfsdk:"id"` // only for test case
+	OrganizationID      types.String         `tfsdk:"organization_id"`
+	StatusPageSubdomain types.String         `tfsdk:"status_page_subdomain"`
+	Integration         chatIntegrationModel `tfsdk:"integration"`
+}
+
+// chatIntegrationModel maps chat integration schema data.
+type chatIntegrationModel struct {
+	ID         types.String `tfsdk:"id"`
+	WebhookUrl types.String `tfsdk:"webhook_url"`
+	Channel    types.String `tfsdk:"channel"`
+	InsertedAt types.String `tfsdk:"inserted_at"`
+	UpdatedAt  types.String `tfsdk:"updated_at"`
+}
+
+// Metadata returns the resource type name.
+func (r *chatIntegrationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.platform.name + "_integration"
+}
+
+// Schema defines the schema for the resource.
+func (r *chatIntegrationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: fmt.Sprintf(
+			"Binds the status page to a %s channel its updates are posted to. The `%s` attribute of the status page must be enabled.",
+			r.platform.title, r.platform.flag,
+		),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier attribute. Ignore it, only used in testing.",
+				Computed:    true,
+			},
+			"organization_id": schema.StringAttribute{
+				Description: "The organization ID of the status page, used to check its notifications are enabled.",
+				Required:    true,
+			},
+			"status_page_subdomain": schema.StringAttribute{
+				Description: "The status page's subdomain where the integration belong.",
+				Required:    true,
+			},
+			"integration": schema.SingleNestedAttribute{
+				Description: fmt.Sprintf("The %s integration.", r.platform.title),
+				Required:    true,
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Description: "The ID of the integration.",
+						Computed:    true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"webhook_url": schema.StringAttribute{
+						Description: fmt.Sprintf("The %s incoming webhook URL the updates are posted to. It embeds the token of the channel, so it is only sent to StatusPal, which never returns it.", r.platform.title),
+						Required:    true,
+						Sensitive:   true,
+					},
+					"channel": schema.StringAttribute{
+						Description: "The name of the channel the updates are posted to, for reference. By default, the channel of the incoming webhook.",
+						Optional:    true,
+					},
+					"inserted_at": schema.StringAttribute{
+						Description: "Datetime at which the integration was inserted.",
+						Computed:    true,
+					},
+					"updated_at": schema.StringAttribute{
+						Description: "Datetime at which the integration was last updated.",
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig checks the webhook URL of the integration.
+func (r *chatIntegrationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var webhookURL types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("integration").AtName("webhook_url"), &webhookURL)...)
+	if resp.Diagnostics.HasError() || webhookURL.IsNull() || webhookURL.IsUnknown() {
+		return
+	}
+
+	// The URL itself is sensitive, so it is not part of the error
+	parsedURL, err := url.Parse(webhookURL.ValueString())
+	if err != nil || parsedURL.Scheme != "https" || parsedURL.Host == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("integration").AtName("webhook_url"),
+			"Invalid StatusPal "+r.platform.title+" Integration Webhook URL",
+			fmt.Sprintf("The webhook URL of the %s integration must be an https URL.", r.platform.title),
+		)
+	}
+}
+
+// ModifyPlan fails when the platform notifications are disabled on an existing
+// status page. The check is skipped when the status page isn't known yet or is
+// created by the same plan.
+func (r *chatIntegrationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when the resource is destroyed or the provider is not configured yet
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	// Nor when the resource doesn't change
+	if !req.State.Raw.IsNull() && req.Plan.Raw.Equal(req.State.Raw) {
+		return
+	}
+
+	var organizationID, statusPageSubdomain types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("organization_id"), &organizationID)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("status_page_subdomain"), &statusPageSubdomain)...)
+	if resp.Diagnostics.HasError() || organizationID.IsUnknown() || statusPageSubdomain.IsUnknown() {
+		return
+	}
+
+	statusPage, err := r.client.GetStatusPage(ctx, organizationID.ValueStringPointer(), statusPageSubdomain.ValueStringPointer())
+	if statuspal.ErrorNotFound(err) {
+		// The status page is created by the same plan
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read StatusPal Status Page",
+			"Could not check the "+r.platform.title+" notifications of the status page "+statusPageSubdomain.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	if !r.platform.enabled(statusPage) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("status_page_subdomain"),
+			"StatusPal "+r.platform.title+" Notifications Disabled",
+			fmt.Sprintf(
+				"The %s notifications of the status page %q are disabled, no update would be posted to the %s channel. Set its %q attribute to true first.",
+				r.platform.title, statusPageSubdomain.ValueString(), r.platform.title, r.platform.flag,
+			),
+		)
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *chatIntegrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan chatIntegrationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	integration := r.mapChatIntegrationModelToRequestBody(&plan.Integration)
+
+	// Create new integration
+	statusPageSubdomain := plan.StatusPageSubdomain.ValueString()
+	newIntegration, err := r.client.CreateChatIntegration(ctx, integration, &statusPageSubdomain)
+	if err != nil {
+		addClientError(&resp.Diagnostics, path.Root("integration"),
+			"Error creating StatusPal "+r.platform.title+" Integration",
+			"Could not create "+r.platform.title+" integration, unexpected error: ",
+			err,
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.Integration = *mapResponseToChatIntegrationModel(newIntegration, &plan.Integration)
+	plan.ID = types.StringValue("placeholder") // only for test case
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *chatIntegrationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state chatIntegrationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed integration value from StatusPal
+	statusPageSubdomain := state.StatusPageSubdomain.ValueString()
+	integrationID := state.Integration.ID.ValueString()
+	integration, err := r.client.GetChatIntegration(ctx, &statusPageSubdomain, &integrationID)
+	if statuspal.ErrorNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading StatusPal "+r.platform.title+" Integration",
+			"Could not read "+r.platform.title+" integration ID "+integrationID+": "+err.Error(),
+		)
+		return
+	}
+
+	if integration.Platform != r.platform.name {
+		resp.Diagnostics.AddError(
+			"Error Reading StatusPal "+r.platform.title+" Integration",
+			fmt.Sprintf(
+				"The chat integration ID %s is not a %s integration but a %q one, manage it with the statuspal_%s_integration resource.",
+				integrationID, r.platform.title, integration.Platform, integration.Platform,
+			),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	state.Integration = *mapResponseToChatIntegrationModel(integration, &state.Integration)
+	state.ID = types.StringValue("placeholder") // only for test case
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *chatIntegrationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan chatIntegrationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	integration := r.mapChatIntegrationModelToRequestBody(&plan.Integration)
+
+	// Update existing integration
+	statusPageSubdomain := plan.StatusPageSubdomain.ValueString()
+	integrationID := plan.Integration.ID.ValueString()
+	updatedIntegration, err := r.client.UpdateChatIntegration(ctx, integration, &statusPageSubdomain, &integrationID)
+	if err != nil {
+		addClientError(&resp.Diagnostics, path.Root("integration"),
+			"Error Updating StatusPal "+r.platform.title+" Integration",
+			"Could not Update "+r.platform.title+" integration, unexpected error: ",
+			err,
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.Integration = *mapResponseToChatIntegrationModel(updatedIntegration, &plan.Integration)
+	plan.ID = types.StringValue("placeholder") // only for test case
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *chatIntegrationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state chatIntegrationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing integration
+	statusPageSubdomain := state.StatusPageSubdomain.ValueString()
+	integrationID := state.Integration.ID.ValueString()
+	err := r.client.DeleteChatIntegration(ctx, &statusPageSubdomain, &integrationID)
+	if err != nil && !statuspal.ErrorNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting StatusPal "+r.platform.title+" Integration",
+			"Could not delete "+r.platform.title+" integration, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *chatIntegrationResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	// Split the ID based on the delimiter used during import
+	parts := strings.Split(req.ID, " ")
+	if len(parts) != 3 {
+		resp.Diagnostics.AddError(
+			"Unexpected StatusPal "+r.platform.title+" Integration Import Identifier",
+			fmt.Sprintf(
+				`Expected StatusPal %s integration import identifier with format: "<organization_id> <status_page_subdomain> <integration_id>"`,
+				r.platform.title,
+			),
+		)
+		return
+	}
+
+	req.ID = parts[0]
+	resource.ImportStatePassthroughID(ctx, path.Root("organization_id"), req, resp)
+	req.ID = parts[1]
+	resource.ImportStatePassthroughID(ctx, path.Root("status_page_subdomain"), req, resp)
+	req.ID = parts[2]
+	resource.ImportStatePassthroughID(ctx, path.Root("integration").AtName("id"), req, resp)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *chatIntegrationResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*statuspal.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf(
+				"Expected *statuspal.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *chatIntegrationResource) mapChatIntegrationModelToRequestBody(integration *chatIntegrationModel) *statuspal.ChatIntegration {
+	return &statuspal.ChatIntegration{
+		Platform:   r.platform.name,
+		WebhookUrl: integration.WebhookUrl.ValueString(),
+		Channel:    integration.Channel.ValueString(),
+	}
+}
+
+func mapResponseToChatIntegrationModel(integration *statuspal.ChatIntegration, previous *chatIntegrationModel) *chatIntegrationModel {
+	channel := types.StringNull()
+	if integration.Channel != "" {
+		channel = types.StringValue(integration.Channel)
+	}
+
+	return &chatIntegrationModel{
+		ID: types.StringValue(strconv.FormatInt(integration.ID, 10)),
+		// The webhook URL is write-only, the API never returns it
+		WebhookUrl: previous.WebhookUrl,
+		Channel:    channel,
+		InsertedAt: types.StringValue(integration.InsertedAt),
+		UpdatedAt:  types.StringValue(integration.UpdatedAt),
+	}
+}
@@ -0,0 +1,177 @@
+package provider
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccChatIntegrationResource(t *testing.T) {
+	// The webhook URL is never returned by the API
+	responseBody := `{
+		"chat_integration": {
+			"id": 1,
+			"platform": "slack",
+			"inserted_at": "2024-05-16T10:00:00",
+			"updated_at": "2024-05-16T10:00:00"
+		}
+	}`
+	updatedResponseBody := `{
+		"chat_integration": {
+			"id": 1,
+			"platform": "slack",
+			"channel": "#status",
+			"inserted_at": "2024-05-16T10:00:00",
+			"updated_at": "2024-05-16T11:00:00"
+		}
+	}`
+
+	var updated atomic.Bool
+	var deleted atomic.Int32
+
+	mux := http.NewServeMux()
+	// The status page has only its Slack subscriptions enabled
+	mux.HandleFunc("GET /orgs/1/status_pages/example-com", func(w http.ResponseWriter, r *http.Request) {
+		if _, err := w.Write([]byte(`{
+			"status_page": {
+				"name": "Example",
+				"subdomain": "example-com",
+				"slack_subscriptions_enabled": true,
+				"discord_notifications_enabled": false
+			}
+		}`)); err != nil {
+			log.Printf(`Error writing "/orgs/1/status_pages/example-com" response: %v`, err)
+		}
+	})
+	mux.HandleFunc("POST /status_pages/example-com/chat_integrations", func(w http.ResponseWriter, r *http.Request) {
+		if _, err := w.Write([]byte(responseBody)); err != nil {
+			log.Printf(`Error writing "/status_pages/example-com/chat_integrations" response: %v`, err)
+		}
+	})
+	mux.HandleFunc("/status_pages/example-com/chat_integrations/1", func(w http.ResponseWriter, r *http.Request) {
+		body := responseBody
+		if updated.Load() {
+			body = updatedResponseBody
+		}
+
+		switch r.Method {
+		case http.MethodPut:
+			updated.Store(true)
+			body = updatedResponseBody
+		case http.MethodDelete:
+			deleted.Add(1)
+			body = `""`
+		}
+
+		if _, err := w.Write([]byte(body)); err != nil {
+			log.Printf(`Error writing "/status_pages/example-com/chat_integrations/1" response with method "%s": %v`, r.Method, err)
+		}
+	})
+	mock := httptest.NewServer(mux)
+	defer mock.Close()
+
+	providerConfig := *providerConfig(&mock.URL)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Disabled notifications error testing
+			{
+				Config: providerConfig + `
+resource "statuspal_discord_integration" "test" {
+  organization_id       = "1"
+  status_page_subdomain = "example-com"
+  integration = {
+    webhook_url = "https://discord.com/api/webhooks/1/token"
+  }
+}
+`,
+				ExpectError: regexp.MustCompile(`Discord notifications of the status page "example-com" are disabled`),
+			},
+			// Status page created by the same plan testing, it doesn't exist yet
+			{
+				Config: providerConfig + `
+resource "statuspal_discord_integration" "test" {
+  organization_id       = "1"
+  status_page_subdomain = "new-example-com"
+  integration = {
+    webhook_url = "https://discord.com/api/webhooks/1/token"
+  }
+}
+`,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			// Invalid webhook URL error testing
+			{
+				Config: providerConfig + `
+resource "statuspal_slack_integration" "test" {
+  organization_id       = "1"
+  status_page_subdomain = "example-com"
+  integration = {
+    webhook_url = "http://hooks.slack.com/services/T0/B0/token"
+  }
+}
+`,
+				ExpectError: regexp.MustCompile(`must be an https URL`),
+			},
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "statuspal_slack_integration" "test" {
+  organization_id       = "1"
+  status_page_subdomain = "example-com"
+  integration = {
+    webhook_url = "https://hooks.slack.com/services/T0/B0/token"
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("statuspal_slack_integration.test", "status_page_subdomain", "example-com"),
+					resource.TestCheckResourceAttr("statuspal_slack_integration.test", "integration.id", "1"),
+					resource.TestCheckResourceAttr("statuspal_slack_integration.test", "integration.webhook_url", "https://hooks.slack.com/services/T0/B0/token"),
+					resource.TestCheckNoResourceAttr("statuspal_slack_integration.test", "integration.channel"),
+					resource.TestCheckResourceAttr("statuspal_slack_integration.test", "integration.inserted_at", "2024-05-16T10:00:00"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "statuspal_slack_integration.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateId:           "1 example-com 1",
+				ImportStateVerifyIgnore: []string{"integration.webhook_url"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "statuspal_slack_integration" "test" {
+  organization_id       = "1"
+  status_page_subdomain = "example-com"
+  integration = {
+    webhook_url = "https://hooks.slack.com/services/T0/B0/token"
+    channel     = "#status"
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("statuspal_slack_integration.test", "integration.id", "1"),
+					resource.TestCheckResourceAttr("statuspal_slack_integration.test", "integration.channel", "#status"),
+					resource.TestCheckResourceAttr("statuspal_slack_integration.test", "integration.updated_at", "2024-05-16T11:00:00"),
+				),
+			},
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			if deleted.Load() != 1 {
+				return fmt.Errorf("expected the Slack integration to be deleted")
+			}
+			return nil
+		},
+	})
+}
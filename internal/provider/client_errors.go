@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	statuspal "terraform-provider-statuspal/internal/client"
+)
+
+// addClientError adds a StatusPal client error to the diagnostics.
+// The fields rejected by the API validation are reported as attribute errors
+// under the root path, e.g. `status_page.subdomain`, so Terraform points to the
+// offending configuration. Any other error is reported as a single error, its
+// detail being prefixed by detailPrefix.
+func addClientError(diags *diag.Diagnostics, root path.Path, summary string, detailPrefix string, err error) {
+	fieldErrors := statuspal.ValidationErrors(err)
+	if len(fieldErrors) == 0 {
+		diags.AddError(summary, detailPrefix+err.Error())
+		return
+	}
+
+	for _, fieldError := range fieldErrors {
+		diags.AddAttributeError(
+			fieldErrorPath(root, fieldError.Field),
+			summary,
+			fmt.Sprintf("The StatusPal API rejected the %s value: %s", fieldError.Field, strings.Join(fieldError.Messages, ", ")),
+		)
+	}
+}
+
+// fieldErrorPath converts the dotted path of an API field, e.g.
+// "monitoring_options.headers.0.key" or "translations.en.name", to the path of
+// the matching attribute under root. Numeric segments are list indexes, and the
+// segment following "translations" is a map key (the locale).
+func fieldErrorPath(root path.Path, field string) path.Path {
+	attributePath := root
+	segments := strings.Split(field, ".")
+
+	for i, segment := range segments {
+		if index, err := strconv.Atoi(segment); err == nil {
+			attributePath = attributePath.AtListIndex(index)
+		} else if i > 0 && segments[i-1] == "translations" {
+			attributePath = attributePath.AtMapKey(segment)
+		} else {
+			attributePath = attributePath.AtName(segment)
+		}
+	}
+
+	return attributePath
+}
@@ -107,7 +107,7 @@ func (r *customDomainValidationResource) Read(ctx context.Context, req resource.
 
 	orgID := state.OrganizationID.ValueString()
 	subdomain := state.StatusPageSubdomain.ValueString()
-	statusPage, err := r.client.GetStatusPage(&orgID, &subdomain)
+	statusPage, err := r.client.GetStatusPage(ctx, &orgID, &subdomain)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading status page for domain validation",
@@ -188,7 +188,7 @@ func (r *customDomainValidationResource) pollUntilActive(ctx context.Context, or
 		default:
 		}
 
-		statusPage, err := r.client.GetStatusPage(&orgID, &subdomain)
+		statusPage, err := r.client.GetStatusPage(ctx, &orgID, &subdomain)
 		if err != nil {
 			return fmt.Errorf("error polling status page %q: %w", subdomain, err)
 		}
@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// dateTimeRegexp matches the ISO 8601 date times accepted by the StatusPal API,
+// e.g. "2024-05-16T10:00:00Z". Date times without a time zone are in UTC.
+var dateTimeRegexp = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}(:\d{2}(\.\d+)?)?(Z|[+-]\d{2}:\d{2})?$`)
+
+// parseDateTime parses an ISO 8601 date time of the API, in UTC when it has no time zone.
+func parseDateTime(value string) (time.Time, error) {
+	for _, layout := range []string{time.RFC3339Nano, "2006-01-02T15:04:05.999999999", "2006-01-02T15:04Z07:00", "2006-01-02T15:04"} {
+		if parsed, err := time.Parse(layout, value); err == nil {
+			return parsed, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("invalid date time %q", value)
+}
+
+// dateTimeValue returns the previous date time when it is the same instant as
+// the value returned by the API, so a date time written differently in the
+// configuration, e.g. with a time zone, doesn't produce a diff.
+func dateTimeValue(previous types.String, value string) types.String {
+	if !previous.IsNull() && !previous.IsUnknown() {
+		previousTime, err := parseDateTime(previous.ValueString())
+		if err == nil {
+			valueTime, err := parseDateTime(value)
+			if err == nil && previousTime.Equal(valueTime) {
+				return previous
+			}
+		}
+	}
+
+	return types.StringValue(value)
+}
+
+// optionalDateTimeValue returns the date time value of an optional date time
+// returned by the API, null when there is none.
+func optionalDateTimeValue(previous types.String, value *string) types.String {
+	if value == nil || *value == "" {
+		return types.StringNull()
+	}
+
+	return dateTimeValue(previous, *value)
+}
+
+// validateEndsAfterStarts checks that the ends_at date time of the object at
+// the given path of the configuration is after its starts_at date time. The
+// detail starts the error message, e.g. "The maintenance must end after it starts".
+func validateEndsAfterStarts(
+	ctx context.Context,
+	config tfsdk.Config,
+	object path.Path,
+	summary string,
+	detail string,
+	diagnostics *diag.Diagnostics,
+) {
+	var startsAt, endsAt types.String
+	diagnostics.Append(config.GetAttribute(ctx, object.AtName("starts_at"), &startsAt)...)
+	diagnostics.Append(config.GetAttribute(ctx, object.AtName("ends_at"), &endsAt)...)
+	if diagnostics.HasError() {
+		return
+	}
+
+	// Unknown values are validated once known, invalid ones by the attribute validators
+	if startsAt.IsNull() || startsAt.IsUnknown() || endsAt.IsNull() || endsAt.IsUnknown() {
+		return
+	}
+
+	starts, err := parseDateTime(startsAt.ValueString())
+	if err != nil {
+		return
+	}
+	ends, err := parseDateTime(endsAt.ValueString())
+	if err != nil {
+		return
+	}
+
+	if !ends.After(starts) {
+		diagnostics.AddAttributeError(
+			object.AtName("ends_at"),
+			summary,
+			fmt.Sprintf("%s, got ends_at %q before or equal to starts_at %q.", detail, endsAt.ValueString(), startsAt.ValueString()),
+		)
+	}
+}
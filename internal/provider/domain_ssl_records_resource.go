@@ -124,7 +124,7 @@ func (r *domainSslRecordsResource) Read(ctx context.Context, req resource.ReadRe
 
 	orgID := state.OrganizationID.ValueString()
 	subdomain := state.StatusPageSubdomain.ValueString()
-	statusPage, err := r.client.GetStatusPage(&orgID, &subdomain)
+	statusPage, err := r.client.GetStatusPage(ctx, &orgID, &subdomain)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading status page for domain SSL records",
@@ -220,7 +220,7 @@ func (r *domainSslRecordsResource) pollUntilCertRecordsReady(ctx context.Context
 		default:
 		}
 
-		statusPage, pollErr := r.client.GetStatusPage(&orgID, &subdomain)
+		statusPage, pollErr := r.client.GetStatusPage(ctx, &orgID, &subdomain)
 		if pollErr != nil {
 			return "", "", fmt.Errorf("error polling status page %q: %w", subdomain, pollErr)
 		}
@@ -103,7 +103,8 @@ func TestAccDomainSslRecordsResource(t *testing.T) {
 func testAccDomainSslRecordsConfig(testURL string) string {
 	return fmt.Sprintf(`
 provider "statuspal" {
-  test_url = %q
+  api_key  = "test"
+  base_url = %q
 }
 
 resource "statuspal_domain_ssl_records" "test" {
@@ -0,0 +1,575 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	statuspal "terraform-provider-statuspal/internal/client"
+)
+
+// customIncidentTypeRegexp matches the IDs of the custom incident types.
+var customIncidentTypeRegexp = regexp.MustCompile(`^\d+$`)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &incidentResource{}
+	_ resource.ResourceWithConfigure   = &incidentResource{}
+	_ resource.ResourceWithImportState = &incidentResource{}
+	_ resource.ResourceWithModifyPlan  = &incidentResource{}
+)
+
+// NewIncidentResource is a helper function to simplify the provider implementation.
+func NewIncidentResource() resource.Resource {
+	return &incidentResource{}
+}
+
+// incidentResource is the resource implementation.
+type incidentResource struct {
+	client *statuspal.Client
+}
+
+// incidentResourceModel maps the resource schema data.
+type incidentResourceModel struct {
+	ID                  types.String  `tfsdk:"id"` // only for test case
+	StatusPageSubdomain types.String  `tfsdk:"status_page_subdomain"`
+	CloseOnDestroy      types.Bool    `tfsdk:"close_on_destroy"`
+	Incident            incidentModel `tfsdk:"incident"`
+}
+
+// incidentModel maps incident schema data.
+type incidentModel struct {
+	ID              types.String `tfsdk:"id"`
+	Title           types.String `tfsdk:"title"`
+	Type            types.String `tfsdk:"type"`
+	Message         types.String `tfsdk:"message"`
+	InitialUpdateID types.String `tfsdk:"initial_update_id"`
+	ServiceIDs      types.List   `tfsdk:"service_ids"`
+	StartsAt        types.String `tfsdk:"starts_at"`
+	EndsAt          types.String `tfsdk:"ends_at"`
+	Notify          types.Bool   `tfsdk:"notify"`
+	Tweet           types.Bool   `tfsdk:"tweet"`
+	Translations    types.Map    `tfsdk:"translations"`
+	Url             types.String `tfsdk:"url"`
+	InsertedAt      types.String `tfsdk:"inserted_at"`
+	UpdatedAt       types.String `tfsdk:"updated_at"`
+}
+
+// incidentTranslationAttrTypes is the type of the incident translations.
+var incidentTranslationAttrTypes = map[string]attr.Type{
+	"title":   types.StringType,
+	"message": types.StringType,
+}
+
+// Metadata returns the resource type name.
+func (r *incidentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_incident"
+}
+
+// Schema defines the schema for the resource.
+func (r *incidentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an incident of the status page.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier attribute. Ignore it, only used in testing.",
+				Computed:    true,
+			},
+			"status_page_subdomain": schema.StringAttribute{
+				Description: "The status page's subdomain where the incident belong.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"close_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: "Close the incident, instead of deleting it, when the resource is destroyed. " +
+					"A closed incident stays in the status page history. Defaults to `false`.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"incident": schema.SingleNestedAttribute{
+				Description: "The incident.",
+				Required:    true,
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Description: "The ID of the incident.",
+						Computed:    true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"title": schema.StringAttribute{
+						Description: "The title of the incident.",
+						Required:    true,
+					},
+					"type": schema.StringAttribute{
+						MarkdownDescription: "The type of the incident:\n" +
+							"  - `minor` - A minor incident is taking place.\n" +
+							"  - `major` - A major incident is taking place.\n" +
+							"  - the ID of a custom incident type, e.g. `statuspal_incident_type.example.incident_type.id` - " +
+							"When the `custom_incident_types_enabled` attribute of the status page is enabled.",
+						Required: true,
+						Validators: []validator.String{
+							stringvalidator.Any(
+								stringvalidator.OneOf("minor", "major"),
+								stringvalidator.RegexMatches(customIncidentTypeRegexp, "must be the ID of a custom incident type"),
+							),
+						},
+					},
+					"message": schema.StringAttribute{
+						Description: "The message of the initial update of the incident.",
+						Required:    true,
+					},
+					"initial_update_id": schema.StringAttribute{
+						Description: "The ID of the initial update of the incident, holding the message.",
+						Computed:    true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"service_ids": schema.ListAttribute{
+						Description: "IDs of the services affected by the incident.",
+						Optional:    true,
+						Computed:    true,
+						ElementType: types.StringType,
+						Default:     listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{})),
+					},
+					"starts_at": schema.StringAttribute{
+						MarkdownDescription: "Datetime at which the incident started, e.g. `2024-05-16T10:00:00Z`. Defaults to the creation time.",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(dateTimeRegexp, "must be an ISO 8601 date time, e.g. 2024-05-16T10:00:00Z"),
+						},
+					},
+					"ends_at": schema.StringAttribute{
+						MarkdownDescription: "Datetime at which the incident ended, e.g. `2024-05-16T12:00:00Z`. The incident is ongoing until it is set. " +
+							"When the incident is closed outside of Terraform, e.g. from the StatusPal dashboard, the end time is kept in the state.",
+						Optional: true,
+						Computed: true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(dateTimeRegexp, "must be an ISO 8601 date time, e.g. 2024-05-16T12:00:00Z"),
+						},
+					},
+					"notify": schema.BoolAttribute{
+						MarkdownDescription: "Notify the status page subscribers about the incident? Defaults to `false`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
+					"tweet": schema.BoolAttribute{
+						MarkdownDescription: "Tweet about the incident? Defaults to `false`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
+					"translations": schema.MapNestedAttribute{
+						MarkdownDescription: "A translations object. For example:\n  ```terraform" + `
+	{
+		fr = {
+			title = "Panne de l'API"
+			message = "Nous enquêtons sur le problème..."
+		}
+	}
+` + "  ```\n→ ",
+						Optional: true,
+						Computed: true,
+						Default:  mapdefault.StaticValue(types.MapNull(types.ObjectType{AttrTypes: incidentTranslationAttrTypes})),
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"title": schema.StringAttribute{
+									Description: "The title of the incident.",
+									Required:    true,
+								},
+								"message": schema.StringAttribute{
+									Description: "The message of the initial update of the incident.",
+									Required:    true,
+								},
+							},
+						},
+					},
+					"url": schema.StringAttribute{
+						Description: "The URL of the incident on the status page.",
+						Computed:    true,
+					},
+					"inserted_at": schema.StringAttribute{
+						Description: "Datetime at which the incident was inserted.",
+						Computed:    true,
+					},
+					"updated_at": schema.StringAttribute{
+						Description: "Datetime at which the incident was last updated.",
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// ModifyPlan checks a custom incident type exists on the status page, which
+// requires its custom incident types to be enabled.
+func (r *incidentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when the resource is destroyed or the provider is not configured yet
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var statusPageSubdomain, incidentType types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("status_page_subdomain"), &statusPageSubdomain)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("incident").AtName("type"), &incidentType)...)
+	if resp.Diagnostics.HasError() || statusPageSubdomain.IsUnknown() || incidentType.IsUnknown() ||
+		!customIncidentTypeRegexp.MatchString(incidentType.ValueString()) {
+		return
+	}
+
+	// Nor when the incident type doesn't change
+	if !req.State.Raw.IsNull() {
+		var previousType types.String
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("incident").AtName("type"), &previousType)...)
+		if resp.Diagnostics.HasError() || previousType.Equal(incidentType) {
+			return
+		}
+	}
+
+	_, err := r.client.GetIncidentType(ctx, statusPageSubdomain.ValueStringPointer(), incidentType.ValueStringPointer())
+	if statuspal.ErrorNotFound(err) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("incident").AtName("type"),
+			"StatusPal Custom Incident Type Not Found",
+			fmt.Sprintf(
+				"The status page %q has no custom incident type with the ID %q. Enable its \"custom_incident_types_enabled\" attribute and create the incident type, "+
+					"e.g. with the statuspal_incident_type resource, or use the \"minor\" or \"major\" type.",
+				statusPageSubdomain.ValueString(), incidentType.ValueString(),
+			),
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read StatusPal Incident Type",
+			"Could not check the incident type "+incidentType.ValueString()+" of the status page "+statusPageSubdomain.ValueString()+": "+err.Error(),
+		)
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *incidentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan incidentResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	incident := mapIncidentModelToRequestBody(ctx, &plan.Incident, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create new incident
+	statusPageSubdomain := plan.StatusPageSubdomain.ValueString()
+	newIncident, err := r.client.CreateIncident(ctx, incident, &statusPageSubdomain)
+	if err != nil {
+		addClientError(&resp.Diagnostics, path.Root("incident"),
+			"Error creating StatusPal Incident",
+			"Could not create incident, unexpected error: ",
+			err,
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	newIncidentModel := mapResponseToIncidentModel(ctx, newIncident, &plan.Incident, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.Incident = *newIncidentModel
+	plan.ID = types.StringValue("placeholder") // only for test case
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *incidentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state incidentResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed incident value from StatusPal
+	statusPageSubdomain := state.StatusPageSubdomain.ValueString()
+	incidentID := state.Incident.ID.ValueString()
+	incident, err := r.client.GetIncident(ctx, &statusPageSubdomain, &incidentID)
+	if statuspal.ErrorNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading StatusPal Incident",
+			"Could not read incident ID "+incidentID+": "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	incidentModel := mapResponseToIncidentModel(ctx, incident, &state.Incident, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Incident = *incidentModel
+	state.ID = types.StringValue("placeholder") // only for test case
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *incidentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan incidentResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	incident := mapIncidentModelToRequestBody(ctx, &plan.Incident, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update existing incident
+	statusPageSubdomain := plan.StatusPageSubdomain.ValueString()
+	incidentID := plan.Incident.ID.ValueString()
+	updatedIncident, err := r.client.UpdateIncident(ctx, incident, &statusPageSubdomain, &incidentID)
+	if err != nil {
+		addClientError(&resp.Diagnostics, path.Root("incident"),
+			"Error Updating StatusPal Incident",
+			"Could not Update incident, unexpected error: ",
+			err,
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	updatedIncidentModel := mapResponseToIncidentModel(ctx, updatedIncident, &plan.Incident, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.Incident = *updatedIncidentModel
+	plan.ID = types.StringValue("placeholder") // only for test case
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *incidentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state incidentResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	statusPageSubdomain := state.StatusPageSubdomain.ValueString()
+	incidentID := state.Incident.ID.ValueString()
+
+	// Close the incident instead of deleting it, unless it is already closed
+	if state.CloseOnDestroy.ValueBool() {
+		if state.Incident.EndsAt.ValueString() != "" {
+			return
+		}
+
+		_, err := r.client.CloseIncident(ctx, &statusPageSubdomain, &incidentID, time.Now().UTC().Format(time.RFC3339))
+		if err != nil && !statuspal.ErrorNotFound(err) {
+			resp.Diagnostics.AddError(
+				"Error Closing StatusPal Incident",
+				"Could not close incident, unexpected error: "+err.Error(),
+			)
+		}
+		return
+	}
+
+	// Delete existing incident
+	err := r.client.DeleteIncident(ctx, &statusPageSubdomain, &incidentID)
+	if err != nil && !statuspal.ErrorNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting StatusPal Incident",
+			"Could not delete incident, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *incidentResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	// Split the ID based on the delimiter used during import
+	parts := strings.Split(req.ID, " ")
+	if len(parts) != 2 {
+		resp.Diagnostics.AddError(
+			"Unexpected StatusPal Incident Import Identifier",
+			`Expected StatusPal incident import identifier with format: "<status_page_subdomain> <incident_id>"`,
+		)
+		return
+	}
+
+	req.ID = parts[0]
+	resource.ImportStatePassthroughID(ctx, path.Root("status_page_subdomain"), req, resp)
+	req.ID = parts[1]
+	resource.ImportStatePassthroughID(ctx, path.Root("incident").AtName("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("close_on_destroy"), false)...)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *incidentResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*statuspal.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf(
+				"Expected *statuspal.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func mapIncidentModelToRequestBody(
+	ctx context.Context,
+	incident *incidentModel,
+	diagnostics *diag.Diagnostics,
+) *statuspal.Incident {
+	serviceIDs := parseServiceIDs(ctx, incident.ServiceIDs, diagnostics)
+	if diagnostics.HasError() {
+		return nil
+	}
+
+	// The message and its translations are held by the initial update of the incident
+	initialUpdate, translationData := mapInitialUpdateToRequestBody(
+		ctx, "issue", incident.Message, incident.InitialUpdateID, incident.Translations, "message", diagnostics,
+	)
+	if diagnostics.HasError() {
+		return nil
+	}
+
+	var endsAt *string
+	if value := incident.EndsAt.ValueString(); value != "" {
+		endsAt = &value
+	}
+
+	return &statuspal.Incident{
+		Title:        incident.Title.ValueString(),
+		Type:         incident.Type.ValueString(),
+		StartsAt:     incident.StartsAt.ValueString(),
+		EndsAt:       endsAt,
+		ServiceIDs:   serviceIDs,
+		Updates:      []statuspal.IncidentUpdate{initialUpdate},
+		Translations: translationData,
+		Notify:       incident.Notify.ValueBool(),
+		Tweet:        incident.Tweet.ValueBool(),
+	}
+}
+
+// mapResponseToIncidentModel maps the incident returned by the API. The values
+// the API doesn't return (notify and tweet flags) are kept from the previous
+// model, as the date times equal to the previous ones written differently.
+func mapResponseToIncidentModel(
+	ctx context.Context,
+	incident *statuspal.Incident,
+	previous *incidentModel,
+	diagnostics *diag.Diagnostics,
+) *incidentModel {
+	message, initialUpdateID, translations := mapResponseToInitialUpdate(
+		incident, previous.Message, incidentTranslationAttrTypes, "message", diagnostics,
+	)
+	if diagnostics.HasError() {
+		return nil
+	}
+
+	serviceIDs := mapServiceIDsToList(ctx, previous.ServiceIDs, incident.ServiceIDs, diagnostics)
+	if diagnostics.HasError() {
+		return nil
+	}
+
+	return &incidentModel{
+		ID:              types.StringValue(strconv.FormatInt(incident.ID, 10)),
+		Title:           types.StringValue(incident.Title),
+		Type:            types.StringValue(incident.Type),
+		Message:         message,
+		InitialUpdateID: initialUpdateID,
+		ServiceIDs:      serviceIDs,
+		StartsAt:        dateTimeValue(previous.StartsAt, incident.StartsAt),
+		EndsAt:          optionalDateTimeValue(previous.EndsAt, incident.EndsAt),
+		Notify:          types.BoolValue(previous.Notify.ValueBool()),
+		Tweet:           types.BoolValue(previous.Tweet.ValueBool()),
+		Translations:    translations,
+		Url:             types.StringValue(incident.Url),
+		InsertedAt:      types.StringValue(incident.InsertedAt),
+		UpdatedAt:       types.StringValue(incident.UpdatedAt),
+	}
+}
@@ -0,0 +1,466 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	statuspal "terraform-provider-statuspal/internal/client"
+)
+
+func TestAccIncidentResource(t *testing.T) {
+	responseBody := `{
+		"incident": {
+			"id": 1,
+			"title": "API outage",
+			"type": "major",
+			"starts_at": "2024-05-16T10:00:00",
+			"ends_at": null,
+			"service_ids": [1, 3],
+			"updates": [
+				{
+					"id": 10,
+					"type": "issue",
+					"description": "We are investigating the issue.",
+					"translations": {"fr": {"description": "Nous enquêtons sur le problème."}}
+				}
+			],
+			"translations": {"fr": {"title": "Panne de l'API"}},
+			"notify": false,
+			"tweet": false,
+			"url": "https://example-com.statuspal.io/incidents/1",
+			"inserted_at": "2024-05-16T10:00:00",
+			"updated_at": "2024-05-16T10:00:00"
+		}
+	}`
+	updatedResponseBody := `{
+		"incident": {
+			"id": 1,
+			"title": "API outage",
+			"type": "minor",
+			"starts_at": "2024-05-16T10:00:00",
+			"ends_at": "2024-05-16T11:30:00",
+			"service_ids": [1, 3],
+			"updates": [
+				{"id": 10, "type": "issue", "description": "We identified the issue.", "translations": {}}
+			],
+			"translations": {},
+			"notify": false,
+			"tweet": false,
+			"url": "https://example-com.statuspal.io/incidents/1",
+			"inserted_at": "2024-05-16T10:00:00",
+			"updated_at": "2024-05-16T11:00:00"
+		}
+	}`
+
+	var createRequest, updateRequest statuspal.IncidentResponse
+	var updated atomic.Bool
+	var deleted atomic.Int32
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /status_pages/example-com/incidents", func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&createRequest); err != nil {
+			http.Error(w, fmt.Sprintf("Failed to decode JSON: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		if _, err := w.Write([]byte(responseBody)); err != nil {
+			log.Printf(`Error writing "/status_pages/example-com/incidents" response: %v`, err)
+		}
+	})
+	mux.HandleFunc("/status_pages/example-com/incidents/1", func(w http.ResponseWriter, r *http.Request) {
+		body := responseBody
+		if updated.Load() {
+			body = updatedResponseBody
+		}
+
+		switch r.Method {
+		case http.MethodPut:
+			if err := json.NewDecoder(r.Body).Decode(&updateRequest); err != nil {
+				http.Error(w, fmt.Sprintf("Failed to decode JSON: %v", err), http.StatusBadRequest)
+				return
+			}
+			updated.Store(true)
+			body = updatedResponseBody
+		case http.MethodDelete:
+			deleted.Add(1)
+			body = `""`
+		}
+
+		if _, err := w.Write([]byte(body)); err != nil {
+			log.Printf(`Error writing "/status_pages/example-com/incidents/1" response with method "%s": %v`, r.Method, err)
+		}
+	})
+	mock := httptest.NewServer(mux)
+	defer mock.Close()
+
+	providerConfig := *providerConfig(&mock.URL)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Invalid type error testing
+			{
+				Config: providerConfig + `
+resource "statuspal_incident" "test" {
+  status_page_subdomain = "example-com"
+  incident = {
+    title   = "API outage"
+    type    = "scheduled"
+    message = "We are investigating the issue."
+  }
+}
+`,
+				ExpectError: regexp.MustCompile(`Attribute incident.type value must be one of`),
+			},
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "statuspal_incident" "test" {
+  status_page_subdomain = "example-com"
+  incident = {
+    title       = "API outage"
+    type        = "major"
+    message     = "We are investigating the issue."
+    service_ids = ["3", "1"]
+    starts_at   = "2024-05-16T10:00:00"
+    notify      = true
+    translations = {
+      fr = {
+        title   = "Panne de l'API"
+        message = "Nous enquêtons sur le problème."
+      }
+    }
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("statuspal_incident.test", "status_page_subdomain", "example-com"),
+					resource.TestCheckResourceAttr("statuspal_incident.test", "close_on_destroy", "false"),
+					resource.TestCheckResourceAttr("statuspal_incident.test", "incident.id", "1"),
+					resource.TestCheckResourceAttr("statuspal_incident.test", "incident.title", "API outage"),
+					resource.TestCheckResourceAttr("statuspal_incident.test", "incident.type", "major"),
+					resource.TestCheckResourceAttr("statuspal_incident.test", "incident.message", "We are investigating the issue."),
+					resource.TestCheckResourceAttr("statuspal_incident.test", "incident.initial_update_id", "10"),
+					resource.TestCheckResourceAttr("statuspal_incident.test", "incident.service_ids.#", "2"),
+					resource.TestCheckResourceAttr("statuspal_incident.test", "incident.service_ids.0", "3"),
+					resource.TestCheckResourceAttr("statuspal_incident.test", "incident.starts_at", "2024-05-16T10:00:00"),
+					resource.TestCheckNoResourceAttr("statuspal_incident.test", "incident.ends_at"),
+					resource.TestCheckResourceAttr("statuspal_incident.test", "incident.notify", "true"),
+					resource.TestCheckResourceAttr("statuspal_incident.test", "incident.tweet", "false"),
+					resource.TestCheckResourceAttr("statuspal_incident.test", "incident.translations.fr.title", "Panne de l'API"),
+					resource.TestCheckResourceAttr("statuspal_incident.test", "incident.translations.fr.message", "Nous enquêtons sur le problème."),
+					resource.TestCheckResourceAttr("statuspal_incident.test", "incident.url", "https://example-com.statuspal.io/incidents/1"),
+					func(_ *terraform.State) error {
+						incident := createRequest.Incident
+						if !incident.Notify || len(incident.Updates) != 1 || incident.Updates[0].Type != "issue" ||
+							incident.Updates[0].Translations["fr"].Description != "Nous enquêtons sur le problème." {
+							return fmt.Errorf("unexpected incident creation request: %+v", incident)
+						}
+						return nil
+					},
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "statuspal_incident.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     "example-com 1",
+				// The notify flag is not returned by the API.
+				ImportStateVerifyIgnore: []string{"incident.notify"},
+			},
+			// Update and Read testing, the date times are written with a time zone
+			{
+				Config: providerConfig + `
+resource "statuspal_incident" "test" {
+  status_page_subdomain = "example-com"
+  incident = {
+    title       = "API outage"
+    type        = "minor"
+    message     = "We identified the issue."
+    service_ids = ["1", "3"]
+    starts_at   = "2024-05-16T12:00:00+02:00"
+    ends_at     = "2024-05-16T11:30:00Z"
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("statuspal_incident.test", "incident.type", "minor"),
+					resource.TestCheckResourceAttr("statuspal_incident.test", "incident.message", "We identified the issue."),
+					resource.TestCheckResourceAttr("statuspal_incident.test", "incident.initial_update_id", "10"),
+					resource.TestCheckResourceAttr("statuspal_incident.test", "incident.service_ids.0", "1"),
+					resource.TestCheckResourceAttr("statuspal_incident.test", "incident.starts_at", "2024-05-16T12:00:00+02:00"),
+					resource.TestCheckResourceAttr("statuspal_incident.test", "incident.ends_at", "2024-05-16T11:30:00Z"),
+					resource.TestCheckNoResourceAttr("statuspal_incident.test", "incident.translations"),
+					func(_ *terraform.State) error {
+						incident := updateRequest.Incident
+						if len(incident.Updates) != 1 || incident.Updates[0].ID != 10 {
+							return fmt.Errorf("expected the initial update to be updated, got: %+v", incident.Updates)
+						}
+						return nil
+					},
+				),
+			},
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			if deleted.Load() != 1 {
+				return fmt.Errorf("expected the incident to be deleted")
+			}
+			return nil
+		},
+	})
+}
+
+func TestAccIncidentResource_CustomType(t *testing.T) {
+	responseBody := `{
+		"incident": {
+			"id": 1,
+			"title": "Slow website",
+			"type": "7",
+			"starts_at": "2024-05-16T10:00:00",
+			"service_ids": [],
+			"updates": [{"id": 10, "type": "issue", "description": "We are investigating the issue."}],
+			"translations": {},
+			"url": "https://example-com.statuspal.io/incidents/1",
+			"inserted_at": "2024-05-16T10:00:00",
+			"updated_at": "2024-05-16T10:00:00"
+		}
+	}`
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/status_pages/example-com/incidents", func(w http.ResponseWriter, r *http.Request) {
+		if _, err := w.Write([]byte(responseBody)); err != nil {
+			log.Printf(`Error writing "/status_pages/example-com/incidents" response: %v`, err)
+		}
+	})
+	mux.HandleFunc("/status_pages/example-com/incidents/1", func(w http.ResponseWriter, r *http.Request) {
+		body := responseBody
+		if r.Method == http.MethodDelete {
+			body = `""`
+		}
+
+		if _, err := w.Write([]byte(body)); err != nil {
+			log.Printf(`Error writing "/status_pages/example-com/incidents/1" response with method "%s": %v`, r.Method, err)
+		}
+	})
+	// The only custom incident type of the status page is the 7
+	mux.HandleFunc("GET /status_pages/example-com/incident_types/7", func(w http.ResponseWriter, r *http.Request) {
+		if _, err := w.Write([]byte(`{"incident_type": {"id": 7, "name": "Degraded", "color": "#FFA500", "severity": "minor"}}`)); err != nil {
+			log.Printf(`Error writing "/status_pages/example-com/incident_types/7" response: %v`, err)
+		}
+	})
+	mock := httptest.NewServer(mux)
+	defer mock.Close()
+
+	providerConfig := *providerConfig(&mock.URL)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unknown custom type error testing
+			{
+				Config: providerConfig + `
+resource "statuspal_incident" "test" {
+  status_page_subdomain = "example-com"
+  incident = {
+    title   = "Slow website"
+    type    = "8"
+    message = "We are investigating the issue."
+  }
+}
+`,
+				ExpectError: regexp.MustCompile(`has no custom incident type with the ID "8"`),
+			},
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "statuspal_incident" "test" {
+  status_page_subdomain = "example-com"
+  incident = {
+    title   = "Slow website"
+    type    = "7"
+    message = "We are investigating the issue."
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("statuspal_incident.test", "incident.type", "7"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIncidentResource_CloseOnDestroy(t *testing.T) {
+	responseBody := `{
+		"incident": {
+			"id": 1,
+			"title": "Game day",
+			"type": "minor",
+			"starts_at": "2024-05-16T10:00:00",
+			"ends_at": null,
+			"service_ids": [],
+			"updates": [{"id": 10, "type": "issue", "description": "We are running a game day."}],
+			"translations": {},
+			"url": "https://example-com.statuspal.io/incidents/1",
+			"inserted_at": "2024-05-16T10:00:00",
+			"updated_at": "2024-05-16T10:00:00"
+		}
+	}`
+
+	var closeRequests []statuspal.IncidentResponse
+	var deleted atomic.Int32
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/status_pages/example-com/incidents", func(w http.ResponseWriter, r *http.Request) {
+		if _, err := w.Write([]byte(responseBody)); err != nil {
+			log.Printf(`Error writing "/status_pages/example-com/incidents" response: %v`, err)
+		}
+	})
+	mux.HandleFunc("/status_pages/example-com/incidents/1", func(w http.ResponseWriter, r *http.Request) {
+		body := responseBody
+
+		switch r.Method {
+		case http.MethodPut:
+			var closeRequest statuspal.IncidentResponse
+			if err := json.NewDecoder(r.Body).Decode(&closeRequest); err != nil {
+				http.Error(w, fmt.Sprintf("Failed to decode JSON: %v", err), http.StatusBadRequest)
+				return
+			}
+			closeRequests = append(closeRequests, closeRequest)
+			body = strings.Replace(body, `"ends_at": null`, `"ends_at": "2024-05-16T12:00:00"`, 1)
+		case http.MethodDelete:
+			deleted.Add(1)
+			body = `""`
+		}
+
+		if _, err := w.Write([]byte(body)); err != nil {
+			log.Printf(`Error writing "/status_pages/example-com/incidents/1" response with method "%s": %v`, r.Method, err)
+		}
+	})
+	mock := httptest.NewServer(mux)
+	defer mock.Close()
+
+	providerConfig := *providerConfig(&mock.URL)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "statuspal_incident" "test" {
+  status_page_subdomain = "example-com"
+  close_on_destroy      = true
+  incident = {
+    title   = "Game day"
+    type    = "minor"
+    message = "We are running a game day."
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("statuspal_incident.test", "close_on_destroy", "true"),
+					resource.TestCheckResourceAttr("statuspal_incident.test", "incident.starts_at", "2024-05-16T10:00:00"),
+				),
+			},
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			if deleted.Load() != 0 {
+				return fmt.Errorf("expected the incident to be closed instead of deleted")
+			}
+			if len(closeRequests) != 1 || closeRequests[0].Incident.EndsAt == nil {
+				return fmt.Errorf("expected the incident to be closed, got: %+v", closeRequests)
+			}
+			return nil
+		},
+	})
+}
+
+func TestAccIncidentResource_ClosedOutsideOfTerraform(t *testing.T) {
+	responseBody := `{
+		"incident": {
+			"id": 1,
+			"title": "Game day",
+			"type": "minor",
+			"starts_at": "2024-05-16T10:00:00",
+			"ends_at": null,
+			"service_ids": [],
+			"updates": [{"id": 10, "type": "issue", "description": "We are running a game day."}],
+			"translations": {},
+			"url": "https://example-com.statuspal.io/incidents/1",
+			"inserted_at": "2024-05-16T10:00:00",
+			"updated_at": "2024-05-16T10:00:00"
+		}
+	}`
+	closedResponseBody := strings.Replace(responseBody, `"ends_at": null`, `"ends_at": "2024-05-16T12:00:00Z"`, 1)
+
+	var closed atomic.Bool
+	var requests atomic.Int32
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/status_pages/example-com/incidents", func(w http.ResponseWriter, r *http.Request) {
+		if _, err := w.Write([]byte(responseBody)); err != nil {
+			log.Printf(`Error writing "/status_pages/example-com/incidents" response: %v`, err)
+		}
+	})
+	mux.HandleFunc("/status_pages/example-com/incidents/1", func(w http.ResponseWriter, r *http.Request) {
+		body := responseBody
+		if closed.Load() {
+			body = closedResponseBody
+		}
+		if r.Method != http.MethodGet {
+			requests.Add(1)
+			body = `""`
+		}
+
+		if _, err := w.Write([]byte(body)); err != nil {
+			log.Printf(`Error writing "/status_pages/example-com/incidents/1" response with method "%s": %v`, r.Method, err)
+		}
+	})
+	mock := httptest.NewServer(mux)
+	defer mock.Close()
+
+	config := *providerConfig(&mock.URL) + `
+resource "statuspal_incident" "test" {
+  status_page_subdomain = "example-com"
+  close_on_destroy      = true
+  incident = {
+    title   = "Game day"
+    type    = "minor"
+    message = "We are running a game day."
+  }
+}
+`
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("statuspal_incident.test", "incident.ends_at"),
+				),
+			},
+			// The end time set outside of Terraform is kept without a diff
+			{
+				PreConfig: func() { closed.Store(true) },
+				Config:    config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("statuspal_incident.test", "incident.ends_at", "2024-05-16T12:00:00Z"),
+				),
+			},
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			if requests.Load() != 0 {
+				return fmt.Errorf("expected the closed incident to be neither closed again nor deleted")
+			}
+			return nil
+		},
+	})
+}
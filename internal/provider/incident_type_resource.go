@@ -0,0 +1,430 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	statuspal "terraform-provider-statuspal/internal/client"
+)
+
+// colorRegexp matches the hexadecimal colors of the status page, e.g. FFA500.
+var colorRegexp = regexp.MustCompile(`^[0-9A-Fa-f]{6}$`)
+
+// incidentTypeSeverities are the built-in incident types the severity of a custom incident type matches.
+var incidentTypeSeverities = []string{"minor", "major"}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &incidentTypeResource{}
+	_ resource.ResourceWithConfigure   = &incidentTypeResource{}
+	_ resource.ResourceWithImportState = &incidentTypeResource{}
+)
+
+// NewIncidentTypeResource is a helper function to simplify the provider implementation.
+func NewIncidentTypeResource() resource.Resource {
+	return &incidentTypeResource{}
+}
+
+// incidentTypeResource is the resource implementation.
+type incidentTypeResource struct {
+	client *statuspal.Client
+}
+
+// incidentTypeResourceModel maps the resource schema data.
+type incidentTypeResourceModel struct {
+	ID                  types.String      `tfsdk:"id"` // only for test case
+	StatusPageSubdomain types.String      `tfsdk:"status_page_subdomain"`
+	IncidentType        incidentTypeModel `tfsdk:"incident_type"`
+}
+
+// incidentTypeModel maps incident type schema data.
+type incidentTypeModel struct {
+	ID           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Color        types.String `tfsdk:"color"`
+	Severity     types.String `tfsdk:"severity"`
+	Translations types.Map    `tfsdk:"translations"`
+	InsertedAt   types.String `tfsdk:"inserted_at"`
+	UpdatedAt    types.String `tfsdk:"updated_at"`
+}
+
+type incidentTypeTranslationsModel map[string]incidentTypeTranslationModel
+
+type incidentTypeTranslationModel struct {
+	Name types.String `tfsdk:"name"`
+}
+
+// incidentTypeTranslationAttrTypes is the type of the incident type translations.
+var incidentTypeTranslationAttrTypes = map[string]attr.Type{
+	"name": types.StringType,
+}
+
+// Metadata returns the resource type name.
+func (r *incidentTypeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_incident_type"
+}
+
+// Schema defines the schema for the resource.
+func (r *incidentTypeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a custom incident type of the status page. The `custom_incident_types_enabled` attribute of the status page must be enabled.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier attribute. Ignore it, only used in testing.",
+				Computed:    true,
+			},
+			"status_page_subdomain": schema.StringAttribute{
+				Description: "The status page's subdomain where the incident type belong.",
+				Required:    true,
+			},
+			"incident_type": schema.SingleNestedAttribute{
+				Description: "The custom incident type.",
+				Required:    true,
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Description: "The ID of the incident type.",
+						Computed:    true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"name": schema.StringAttribute{
+						Description: "The name of the incident type.",
+						Required:    true,
+					},
+					"color": schema.StringAttribute{
+						Description: "The hexadecimal color of the incidents of this type, e.g. FFA500.",
+						Required:    true,
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(colorRegexp, "must be a hexadecimal color without the leading #, e.g. FFA500"),
+						},
+					},
+					"severity": schema.StringAttribute{
+						MarkdownDescription: "Enum: " + quotedValuesDescription(incidentTypeSeverities) + "\n  The built-in incident type the incidents of this type affect the status of the services as.",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(incidentTypeSeverities...),
+						},
+					},
+					"translations": schema.MapNestedAttribute{
+						MarkdownDescription: "A translations object. For example:\n  ```terraform" + `
+	{
+		fr = {
+			name = "Dégradation des performances"
+		}
+	}
+` + "  ```\n→ ",
+						Optional: true,
+						Computed: true,
+						Default:  mapdefault.StaticValue(types.MapNull(types.ObjectType{AttrTypes: incidentTypeTranslationAttrTypes})),
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"name": schema.StringAttribute{
+									Description: "The name of the incident type.",
+									Required:    true,
+								},
+							},
+						},
+					},
+					"inserted_at": schema.StringAttribute{
+						Description: "Datetime at which the incident type was inserted.",
+						Computed:    true,
+					},
+					"updated_at": schema.StringAttribute{
+						Description: "Datetime at which the incident type was last updated.",
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *incidentTypeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan incidentTypeResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	incidentType := mapIncidentTypeModelToRequestBody(ctx, &plan.IncidentType, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create new incident type
+	statusPageSubdomain := plan.StatusPageSubdomain.ValueString()
+	newIncidentType, err := r.client.CreateIncidentType(ctx, incidentType, &statusPageSubdomain)
+	if err != nil {
+		addClientError(&resp.Diagnostics, path.Root("incident_type"),
+			"Error creating StatusPal Incident Type",
+			"Could not create incident type, unexpected error: ",
+			err,
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	incidentTypeModel := mapResponseToIncidentTypeModel(newIncidentType, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.IncidentType = *incidentTypeModel
+	plan.ID = types.StringValue("placeholder") // only for test case
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *incidentTypeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state incidentTypeResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed incident type value from StatusPal
+	statusPageSubdomain := state.StatusPageSubdomain.ValueString()
+	incidentTypeID := state.IncidentType.ID.ValueString()
+	incidentType, err := r.client.GetIncidentType(ctx, &statusPageSubdomain, &incidentTypeID)
+	if statuspal.ErrorNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading StatusPal Incident Type",
+			"Could not read incident type ID "+incidentTypeID+": "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	incidentTypeModel := mapResponseToIncidentTypeModel(incidentType, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.IncidentType = *incidentTypeModel
+	state.ID = types.StringValue("placeholder") // only for test case
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *incidentTypeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan incidentTypeResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	incidentType := mapIncidentTypeModelToRequestBody(ctx, &plan.IncidentType, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update existing incident type
+	statusPageSubdomain := plan.StatusPageSubdomain.ValueString()
+	incidentTypeID := plan.IncidentType.ID.ValueString()
+	updatedIncidentType, err := r.client.UpdateIncidentType(ctx, incidentType, &statusPageSubdomain, &incidentTypeID)
+	if err != nil {
+		addClientError(&resp.Diagnostics, path.Root("incident_type"),
+			"Error Updating StatusPal Incident Type",
+			"Could not Update incident type, unexpected error: ",
+			err,
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	incidentTypeModel := mapResponseToIncidentTypeModel(updatedIncidentType, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.IncidentType = *incidentTypeModel
+	plan.ID = types.StringValue("placeholder") // only for test case
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *incidentTypeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state incidentTypeResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing incident type
+	statusPageSubdomain := state.StatusPageSubdomain.ValueString()
+	incidentTypeID := state.IncidentType.ID.ValueString()
+	err := r.client.DeleteIncidentType(ctx, &statusPageSubdomain, &incidentTypeID)
+	if err != nil && !statuspal.ErrorNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting StatusPal Incident Type",
+			"Could not delete incident type, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *incidentTypeResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	// Split the ID based on the delimiter used during import
+	parts := strings.Split(req.ID, " ")
+	if len(parts) != 2 {
+		resp.Diagnostics.AddError(
+			"Unexpected StatusPal Incident Type Import Identifier",
+			`Expected StatusPal incident type import identifier with format: "<status_page_subdomain> <incident_type_id>"`,
+		)
+		return
+	}
+
+	req.ID = parts[0]
+	resource.ImportStatePassthroughID(ctx, path.Root("status_page_subdomain"), req, resp)
+	req.ID = parts[1]
+	resource.ImportStatePassthroughID(ctx, path.Root("incident_type").AtName("id"), req, resp)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *incidentTypeResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*statuspal.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf(
+				"Expected *statuspal.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func mapIncidentTypeModelToRequestBody(
+	ctx context.Context,
+	incidentType *incidentTypeModel,
+	diagnostics *diag.Diagnostics,
+) *statuspal.IncidentType {
+	translationData := make(statuspal.IncidentTypeTranslations)
+	if !incidentType.Translations.IsNull() && !incidentType.Translations.IsUnknown() {
+		translations := make(incidentTypeTranslationsModel, len(incidentType.Translations.Elements()))
+		diags := incidentType.Translations.ElementsAs(ctx, &translations, false)
+		diagnostics.Append(diags...)
+		if diagnostics.HasError() {
+			return nil
+		}
+
+		for lang, data := range translations {
+			translationData[lang] = statuspal.IncidentTypeTranslation{
+				Name: data.Name.ValueString(),
+			}
+		}
+	}
+
+	return &statuspal.IncidentType{
+		Name:         incidentType.Name.ValueString(),
+		Color:        incidentType.Color.ValueString(),
+		Severity:     incidentType.Severity.ValueString(),
+		Translations: translationData,
+	}
+}
+
+func mapResponseToIncidentTypeModel(incidentType *statuspal.IncidentType, diagnostics *diag.Diagnostics) *incidentTypeModel {
+	translations := types.MapNull(types.ObjectType{AttrTypes: incidentTypeTranslationAttrTypes})
+	if len(incidentType.Translations) > 0 {
+		translationData := make(map[string]attr.Value)
+		for lang, data := range incidentType.Translations {
+			translationObject, diags := types.ObjectValue(
+				incidentTypeTranslationAttrTypes,
+				map[string]attr.Value{
+					"name": types.StringValue(data.Name),
+				},
+			)
+			translationData[lang] = translationObject
+			diagnostics.Append(diags...)
+			if diagnostics.HasError() {
+				return nil
+			}
+		}
+
+		convertedTranslations, diags := types.MapValue(
+			types.ObjectType{AttrTypes: incidentTypeTranslationAttrTypes},
+			translationData,
+		)
+		diagnostics.Append(diags...)
+		if diagnostics.HasError() {
+			return nil
+		}
+
+		translations = convertedTranslations
+	}
+
+	return &incidentTypeModel{
+		ID:           types.StringValue(strconv.FormatInt(incidentType.ID, 10)),
+		Name:         types.StringValue(incidentType.Name),
+		Color:        types.StringValue(incidentType.Color),
+		Severity:     types.StringValue(incidentType.Severity),
+		Translations: translations,
+		InsertedAt:   types.StringValue(incidentType.InsertedAt),
+		UpdatedAt:    types.StringValue(incidentType.UpdatedAt),
+	}
+}
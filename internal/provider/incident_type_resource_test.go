@@ -0,0 +1,151 @@
+package provider
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccIncidentTypeResource(t *testing.T) {
+	responseBody := `{
+		"incident_type": {
+			"id": 1,
+			"name": "Degraded performance",
+			"color": "FFA500",
+			"severity": "minor",
+			"translations": {},
+			"inserted_at": "2024-05-16T10:00:00",
+			"updated_at": "2024-05-16T10:00:00"
+		}
+	}`
+	updatedResponseBody := `{
+		"incident_type": {
+			"id": 1,
+			"name": "Partial outage",
+			"color": "FF4500",
+			"severity": "major",
+			"translations": {"fr": {"name": "Panne partielle"}},
+			"inserted_at": "2024-05-16T10:00:00",
+			"updated_at": "2024-05-16T11:00:00"
+		}
+	}`
+
+	var updated atomic.Bool
+	var deleted atomic.Int32
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /status_pages/example-com/incident_types", func(w http.ResponseWriter, r *http.Request) {
+		if _, err := w.Write([]byte(responseBody)); err != nil {
+			log.Printf(`Error writing "/status_pages/example-com/incident_types" response: %v`, err)
+		}
+	})
+	mux.HandleFunc("/status_pages/example-com/incident_types/1", func(w http.ResponseWriter, r *http.Request) {
+		body := responseBody
+		if updated.Load() {
+			body = updatedResponseBody
+		}
+
+		switch r.Method {
+		case http.MethodPut:
+			updated.Store(true)
+			body = updatedResponseBody
+		case http.MethodDelete:
+			deleted.Add(1)
+			body = `""`
+		}
+
+		if _, err := w.Write([]byte(body)); err != nil {
+			log.Printf(`Error writing "/status_pages/example-com/incident_types/1" response with method "%s": %v`, r.Method, err)
+		}
+	})
+	mock := httptest.NewServer(mux)
+	defer mock.Close()
+
+	providerConfig := *providerConfig(&mock.URL)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Invalid color error testing
+			{
+				Config: providerConfig + `
+resource "statuspal_incident_type" "test" {
+  status_page_subdomain = "example-com"
+  incident_type = {
+    name     = "Degraded performance"
+    color    = "#FFA500"
+    severity = "minor"
+  }
+}
+`,
+				ExpectError: regexp.MustCompile(`must be a hexadecimal color`),
+			},
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "statuspal_incident_type" "test" {
+  status_page_subdomain = "example-com"
+  incident_type = {
+    name     = "Degraded performance"
+    color    = "FFA500"
+    severity = "minor"
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("statuspal_incident_type.test", "status_page_subdomain", "example-com"),
+					resource.TestCheckResourceAttr("statuspal_incident_type.test", "incident_type.id", "1"),
+					resource.TestCheckResourceAttr("statuspal_incident_type.test", "incident_type.name", "Degraded performance"),
+					resource.TestCheckResourceAttr("statuspal_incident_type.test", "incident_type.color", "FFA500"),
+					resource.TestCheckResourceAttr("statuspal_incident_type.test", "incident_type.severity", "minor"),
+					resource.TestCheckNoResourceAttr("statuspal_incident_type.test", "incident_type.translations"),
+					resource.TestCheckResourceAttr("statuspal_incident_type.test", "incident_type.inserted_at", "2024-05-16T10:00:00"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "statuspal_incident_type.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     "example-com 1",
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "statuspal_incident_type" "test" {
+  status_page_subdomain = "example-com"
+  incident_type = {
+    name     = "Partial outage"
+    color    = "FF4500"
+    severity = "major"
+    translations = {
+      fr = {
+        name = "Panne partielle"
+      }
+    }
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("statuspal_incident_type.test", "incident_type.id", "1"),
+					resource.TestCheckResourceAttr("statuspal_incident_type.test", "incident_type.name", "Partial outage"),
+					resource.TestCheckResourceAttr("statuspal_incident_type.test", "incident_type.severity", "major"),
+					resource.TestCheckResourceAttr("statuspal_incident_type.test", "incident_type.translations.fr.name", "Panne partielle"),
+					resource.TestCheckResourceAttr("statuspal_incident_type.test", "incident_type.updated_at", "2024-05-16T11:00:00"),
+				),
+			},
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			if deleted.Load() != 1 {
+				return fmt.Errorf("expected the incident type to be deleted")
+			}
+			return nil
+		},
+	})
+}
@@ -0,0 +1,494 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	statuspal "terraform-provider-statuspal/internal/client"
+)
+
+// incidentUpdateStateTypes maps each state of an incident update to the type
+// of update posted when no type override is set.
+var incidentUpdateStateTypes = map[string]string{
+	"identified": "update",
+	"monitoring": "update",
+	"resolved":   "resolve",
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &incidentUpdateResource{}
+	_ resource.ResourceWithConfigure   = &incidentUpdateResource{}
+	_ resource.ResourceWithImportState = &incidentUpdateResource{}
+)
+
+// NewIncidentUpdateResource is a helper function to simplify the provider implementation.
+func NewIncidentUpdateResource() resource.Resource {
+	return &incidentUpdateResource{}
+}
+
+// incidentUpdateResource is the resource implementation.
+type incidentUpdateResource struct {
+	client *statuspal.Client
+}
+
+// incidentUpdateResourceModel maps the resource schema data.
+type incidentUpdateResourceModel struct {
+	ID                  types.String        `tfsdk:"id"` // only for test case
+	StatusPageSubdomain types.String        `tfsdk:"status_page_subdomain"`
+	IncidentID          types.String        `tfsdk:"incident_id"`
+	IncidentUpdate      incidentUpdateModel `tfsdk:"incident_update"`
+}
+
+// incidentUpdateModel maps incident update schema data.
+type incidentUpdateModel struct {
+	ID           types.String `tfsdk:"id"`
+	State        types.String `tfsdk:"state"`
+	Type         types.String `tfsdk:"type"`
+	Message      types.String `tfsdk:"message"`
+	Notify       types.Bool   `tfsdk:"notify"`
+	Tweet        types.Bool   `tfsdk:"tweet"`
+	Translations types.Map    `tfsdk:"translations"`
+	InsertedAt   types.String `tfsdk:"inserted_at"`
+	UpdatedAt    types.String `tfsdk:"updated_at"`
+}
+
+type incidentUpdateTranslationsModel map[string]incidentUpdateTranslationModel
+
+type incidentUpdateTranslationModel struct {
+	Message types.String `tfsdk:"message"`
+}
+
+// incidentUpdateTranslationAttrTypes is the type of the incident update translations.
+var incidentUpdateTranslationAttrTypes = map[string]attr.Type{
+	"message": types.StringType,
+}
+
+// Metadata returns the resource type name.
+func (r *incidentUpdateResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_incident_update"
+}
+
+// Schema defines the schema for the resource.
+func (r *incidentUpdateResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages an update posted on an incident of the status page. " +
+			"Use `depends_on` between the updates of an incident to post them in order.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier attribute. Ignore it, only used in testing.",
+				Computed:    true,
+			},
+			"status_page_subdomain": schema.StringAttribute{
+				Description: "The status page's subdomain where the incident belong.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"incident_id": schema.StringAttribute{
+				Description: "The ID of the incident the update is posted on.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"incident_update": schema.SingleNestedAttribute{
+				Description: "The incident update.",
+				Required:    true,
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Description: "The ID of the incident update.",
+						Computed:    true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"state": schema.StringAttribute{
+						MarkdownDescription: "Enum: `\"identified\"` `\"monitoring\"` `\"resolved\"`\n  The state of the incident reported by the update:\n" +
+							"  - `identified` - The cause of the incident is identified.\n" +
+							"  - `monitoring` - A fix is implemented and its results are monitored.\n" +
+							"  - `resolved` - The incident is resolved.",
+						Required: true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("identified", "monitoring", "resolved"),
+						},
+					},
+					"type": schema.StringAttribute{
+						MarkdownDescription: "Enum: `\"issue\"` `\"update\"` `\"resolve\"` `\"retrospective\"`\n  Overrides the type of the update. " +
+							"By default, a `resolved` update is a `resolve` update, other updates are `update` updates.",
+						Optional: true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("issue", "update", "resolve", "retrospective"),
+						},
+					},
+					"message": schema.StringAttribute{
+						Description: "The message of the update.",
+						Required:    true,
+					},
+					"notify": schema.BoolAttribute{
+						MarkdownDescription: "Notify the status page subscribers about the update? Defaults to `false`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
+					"tweet": schema.BoolAttribute{
+						MarkdownDescription: "Tweet about the update? Defaults to `false`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
+					"translations": schema.MapNestedAttribute{
+						MarkdownDescription: "A translations object. For example:\n  ```terraform" + `
+	{
+		fr = {
+			message = "Nous avons identifié le problème."
+		}
+	}
+` + "  ```\n→ ",
+						Optional: true,
+						Computed: true,
+						Default:  mapdefault.StaticValue(types.MapNull(types.ObjectType{AttrTypes: incidentUpdateTranslationAttrTypes})),
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"message": schema.StringAttribute{
+									Description: "The message of the update.",
+									Required:    true,
+								},
+							},
+						},
+					},
+					"inserted_at": schema.StringAttribute{
+						Description: "Datetime at which the update was inserted.",
+						Computed:    true,
+					},
+					"updated_at": schema.StringAttribute{
+						Description: "Datetime at which the update was last updated.",
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *incidentUpdateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan incidentUpdateResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	update := mapIncidentUpdateModelToRequestBody(ctx, &plan.IncidentUpdate, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Post new incident update
+	statusPageSubdomain := plan.StatusPageSubdomain.ValueString()
+	incidentID := plan.IncidentID.ValueString()
+	newUpdate, err := r.client.CreateIncidentUpdate(ctx, update, &statusPageSubdomain, &incidentID)
+	if err != nil {
+		addClientError(&resp.Diagnostics, path.Root("incident_update"),
+			"Error creating StatusPal Incident Update",
+			"Could not create incident update, unexpected error: ",
+			err,
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	newUpdateModel := mapResponseToIncidentUpdateModel(newUpdate, &plan.IncidentUpdate, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.IncidentUpdate = *newUpdateModel
+	plan.ID = types.StringValue("placeholder") // only for test case
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *incidentUpdateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state incidentUpdateResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed incident update value from StatusPal
+	statusPageSubdomain := state.StatusPageSubdomain.ValueString()
+	incidentID := state.IncidentID.ValueString()
+	updateID := state.IncidentUpdate.ID.ValueString()
+	update, err := r.client.GetIncidentUpdate(ctx, &statusPageSubdomain, &incidentID, &updateID)
+	if statuspal.ErrorNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading StatusPal Incident Update",
+			"Could not read incident update ID "+updateID+": "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	updateModel := mapResponseToIncidentUpdateModel(update, &state.IncidentUpdate, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.IncidentUpdate = *updateModel
+	state.ID = types.StringValue("placeholder") // only for test case
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *incidentUpdateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan incidentUpdateResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	update := mapIncidentUpdateModelToRequestBody(ctx, &plan.IncidentUpdate, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update existing incident update
+	statusPageSubdomain := plan.StatusPageSubdomain.ValueString()
+	incidentID := plan.IncidentID.ValueString()
+	updateID := plan.IncidentUpdate.ID.ValueString()
+	updatedUpdate, err := r.client.UpdateIncidentUpdate(ctx, update, &statusPageSubdomain, &incidentID, &updateID)
+	if err != nil {
+		addClientError(&resp.Diagnostics, path.Root("incident_update"),
+			"Error Updating StatusPal Incident Update",
+			"Could not Update incident update, unexpected error: ",
+			err,
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	updatedUpdateModel := mapResponseToIncidentUpdateModel(updatedUpdate, &plan.IncidentUpdate, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.IncidentUpdate = *updatedUpdateModel
+	plan.ID = types.StringValue("placeholder") // only for test case
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *incidentUpdateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state incidentUpdateResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing incident update, it is already gone with a deleted incident
+	statusPageSubdomain := state.StatusPageSubdomain.ValueString()
+	incidentID := state.IncidentID.ValueString()
+	updateID := state.IncidentUpdate.ID.ValueString()
+	err := r.client.DeleteIncidentUpdate(ctx, &statusPageSubdomain, &incidentID, &updateID)
+	if err != nil && !statuspal.ErrorNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting StatusPal Incident Update",
+			"Could not delete incident update, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *incidentUpdateResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	// Split the ID based on the delimiter used during import
+	parts := strings.Split(req.ID, " ")
+	if len(parts) != 3 {
+		resp.Diagnostics.AddError(
+			"Unexpected StatusPal Incident Update Import Identifier",
+			`Expected StatusPal incident update import identifier with format: "<status_page_subdomain> <incident_id> <incident_update_id>"`,
+		)
+		return
+	}
+
+	req.ID = parts[0]
+	resource.ImportStatePassthroughID(ctx, path.Root("status_page_subdomain"), req, resp)
+	req.ID = parts[1]
+	resource.ImportStatePassthroughID(ctx, path.Root("incident_id"), req, resp)
+	req.ID = parts[2]
+	resource.ImportStatePassthroughID(ctx, path.Root("incident_update").AtName("id"), req, resp)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *incidentUpdateResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*statuspal.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf(
+				"Expected *statuspal.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func mapIncidentUpdateModelToRequestBody(
+	ctx context.Context,
+	update *incidentUpdateModel,
+	diagnostics *diag.Diagnostics,
+) *statuspal.IncidentUpdate {
+	translationData := make(statuspal.IncidentUpdateTranslations)
+	if !update.Translations.IsNull() && !update.Translations.IsUnknown() {
+		translations := make(incidentUpdateTranslationsModel, len(update.Translations.Elements()))
+		diags := update.Translations.ElementsAs(ctx, &translations, false)
+		diagnostics.Append(diags...)
+		if diagnostics.HasError() {
+			return nil
+		}
+
+		for lang, data := range translations {
+			translationData[lang] = statuspal.IncidentUpdateTranslation{
+				Description: data.Message.ValueString(),
+			}
+		}
+	}
+
+	updateType := update.Type.ValueString()
+	if updateType == "" {
+		updateType = incidentUpdateStateTypes[update.State.ValueString()]
+	}
+
+	return &statuspal.IncidentUpdate{
+		Type:         updateType,
+		Subtype:      update.State.ValueString(),
+		Description:  update.Message.ValueString(),
+		Translations: translationData,
+		Notify:       update.Notify.ValueBool(),
+		Tweet:        update.Tweet.ValueBool(),
+	}
+}
+
+func mapResponseToIncidentUpdateModel(
+	update *statuspal.IncidentUpdate,
+	previous *incidentUpdateModel,
+	diagnostics *diag.Diagnostics,
+) *incidentUpdateModel {
+	state := previous.State
+	if update.Subtype != "" {
+		state = types.StringValue(update.Subtype)
+	}
+
+	// The type stays null unless it overrides the type of the state
+	updateType := types.StringValue(update.Type)
+	if previous.Type.IsNull() && update.Type == incidentUpdateStateTypes[state.ValueString()] {
+		updateType = types.StringNull()
+	}
+
+	translations := types.MapNull(types.ObjectType{AttrTypes: incidentUpdateTranslationAttrTypes})
+	if len(update.Translations) > 0 {
+		// Create the translationData object dynamically
+		translationData := make(map[string]attr.Value)
+		for lang, data := range update.Translations {
+			translationObject, diags := types.ObjectValue(
+				incidentUpdateTranslationAttrTypes,
+				map[string]attr.Value{
+					"message": types.StringValue(data.Description),
+				},
+			)
+			translationData[lang] = translationObject
+			diagnostics.Append(diags...)
+			if diagnostics.HasError() {
+				return nil
+			}
+		}
+		// Create the translations map
+		convertedTranslations, diags := types.MapValue(
+			types.ObjectType{AttrTypes: incidentUpdateTranslationAttrTypes},
+			translationData,
+		)
+		diagnostics.Append(diags...)
+		if diagnostics.HasError() {
+			return nil
+		}
+
+		translations = convertedTranslations
+	}
+
+	return &incidentUpdateModel{
+		ID:      types.StringValue(strconv.FormatInt(update.ID, 10)),
+		State:   state,
+		Type:    updateType,
+		Message: types.StringValue(update.Description),
+		// The notify and tweet flags only apply when posting the update, they are not returned by the API
+		Notify:       types.BoolValue(previous.Notify.ValueBool()),
+		Tweet:        types.BoolValue(previous.Tweet.ValueBool()),
+		Translations: translations,
+		InsertedAt:   types.StringValue(update.InsertedAt),
+		UpdatedAt:    types.StringValue(update.UpdatedAt),
+	}
+}
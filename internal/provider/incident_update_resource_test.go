@@ -0,0 +1,248 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	statuspal "terraform-provider-statuspal/internal/client"
+)
+
+func TestAccIncidentUpdateResource(t *testing.T) {
+	identifiedResponseBody := `{
+		"incident_update": {
+			"id": 11,
+			"type": "update",
+			"subtype": "identified",
+			"description": "We identified the issue.",
+			"translations": {"fr": {"description": "Nous avons identifié le problème."}},
+			"inserted_at": "2024-05-16T10:00:00",
+			"updated_at": "2024-05-16T10:00:00"
+		}
+	}`
+	monitoringResponseBody := `{
+		"incident_update": {
+			"id": 11,
+			"type": "update",
+			"subtype": "monitoring",
+			"description": "A fix is deployed, we are monitoring the results.",
+			"translations": {},
+			"inserted_at": "2024-05-16T10:00:00",
+			"updated_at": "2024-05-16T11:00:00"
+		}
+	}`
+	resolvedResponseBody := `{
+		"incident_update": {
+			"id": 12,
+			"type": "retrospective",
+			"subtype": "resolved",
+			"description": "The issue is resolved.",
+			"translations": {},
+			"inserted_at": "2024-05-16T10:00:00",
+			"updated_at": "2024-05-16T10:00:00"
+		}
+	}`
+	updatedResolvedResponseBody := `{
+		"incident_update": {
+			"id": 12,
+			"type": "resolve",
+			"subtype": "resolved",
+			"description": "The issue is resolved.",
+			"translations": {},
+			"inserted_at": "2024-05-16T10:00:00",
+			"updated_at": "2024-05-16T11:00:00"
+		}
+	}`
+
+	var createRequests, updateRequests []statuspal.IncidentUpdateResponse
+	var identifiedUpdated, resolvedUpdated atomic.Bool
+	var deleted atomic.Int32
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /status_pages/example-com/incidents/1/updates", func(w http.ResponseWriter, r *http.Request) {
+		var createRequest statuspal.IncidentUpdateResponse
+		if err := json.NewDecoder(r.Body).Decode(&createRequest); err != nil {
+			http.Error(w, fmt.Sprintf("Failed to decode JSON: %v", err), http.StatusBadRequest)
+			return
+		}
+		createRequests = append(createRequests, createRequest)
+
+		body := identifiedResponseBody
+		if createRequest.IncidentUpdate.Subtype == "resolved" {
+			body = resolvedResponseBody
+		}
+
+		if _, err := w.Write([]byte(body)); err != nil {
+			log.Printf(`Error writing "/status_pages/example-com/incidents/1/updates" response: %v`, err)
+		}
+	})
+	handleUpdate := func(path, responseBody, updatedResponseBody string, updated *atomic.Bool) {
+		mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			body := responseBody
+			if updated.Load() {
+				body = updatedResponseBody
+			}
+
+			switch r.Method {
+			case http.MethodPut:
+				var updateRequest statuspal.IncidentUpdateResponse
+				if err := json.NewDecoder(r.Body).Decode(&updateRequest); err != nil {
+					http.Error(w, fmt.Sprintf("Failed to decode JSON: %v", err), http.StatusBadRequest)
+					return
+				}
+				updateRequests = append(updateRequests, updateRequest)
+				updated.Store(true)
+				body = updatedResponseBody
+			case http.MethodDelete:
+				deleted.Add(1)
+				body = `""`
+			}
+
+			if _, err := w.Write([]byte(body)); err != nil {
+				log.Printf(`Error writing "%s" response with method "%s": %v`, path, r.Method, err)
+			}
+		})
+	}
+	handleUpdate("/status_pages/example-com/incidents/1/updates/11", identifiedResponseBody, monitoringResponseBody, &identifiedUpdated)
+	handleUpdate("/status_pages/example-com/incidents/1/updates/12", resolvedResponseBody, updatedResolvedResponseBody, &resolvedUpdated)
+	mock := httptest.NewServer(mux)
+	defer mock.Close()
+
+	providerConfig := *providerConfig(&mock.URL)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Invalid state error testing
+			{
+				Config: providerConfig + `
+resource "statuspal_incident_update" "test" {
+  status_page_subdomain = "example-com"
+  incident_id           = "1"
+  incident_update = {
+    state   = "investigating"
+    message = "We are investigating the issue."
+  }
+}
+`,
+				ExpectError: regexp.MustCompile(`Attribute incident_update.state value must be one of`),
+			},
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "statuspal_incident_update" "identified" {
+  status_page_subdomain = "example-com"
+  incident_id           = "1"
+  incident_update = {
+    state   = "identified"
+    message = "We identified the issue."
+    notify  = true
+    translations = {
+      fr = {
+        message = "Nous avons identifié le problème."
+      }
+    }
+  }
+}
+
+resource "statuspal_incident_update" "resolved" {
+  status_page_subdomain = "example-com"
+  incident_id           = "1"
+  incident_update = {
+    state   = "resolved"
+    type    = "retrospective"
+    message = "The issue is resolved."
+  }
+
+  depends_on = [statuspal_incident_update.identified]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("statuspal_incident_update.identified", "incident_id", "1"),
+					resource.TestCheckResourceAttr("statuspal_incident_update.identified", "incident_update.id", "11"),
+					resource.TestCheckResourceAttr("statuspal_incident_update.identified", "incident_update.state", "identified"),
+					resource.TestCheckNoResourceAttr("statuspal_incident_update.identified", "incident_update.type"),
+					resource.TestCheckResourceAttr("statuspal_incident_update.identified", "incident_update.message", "We identified the issue."),
+					resource.TestCheckResourceAttr("statuspal_incident_update.identified", "incident_update.notify", "true"),
+					resource.TestCheckResourceAttr("statuspal_incident_update.identified", "incident_update.tweet", "false"),
+					resource.TestCheckResourceAttr("statuspal_incident_update.identified", "incident_update.translations.fr.message", "Nous avons identifié le problème."),
+					resource.TestCheckResourceAttr("statuspal_incident_update.resolved", "incident_update.id", "12"),
+					resource.TestCheckResourceAttr("statuspal_incident_update.resolved", "incident_update.type", "retrospective"),
+					func(_ *terraform.State) error {
+						if len(createRequests) != 2 {
+							return fmt.Errorf("expected 2 incident updates to be posted, got: %+v", createRequests)
+						}
+						if update := createRequests[0].IncidentUpdate; update.Type != "update" || update.Subtype != "identified" || !update.Notify {
+							return fmt.Errorf("unexpected identified update creation request: %+v", update)
+						}
+						if update := createRequests[1].IncidentUpdate; update.Type != "retrospective" || update.Subtype != "resolved" {
+							return fmt.Errorf("unexpected resolved update creation request: %+v", update)
+						}
+						return nil
+					},
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "statuspal_incident_update.identified",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     "example-com 1 11",
+				// The notify flag is not returned by the API.
+				ImportStateVerifyIgnore: []string{"incident_update.notify"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "statuspal_incident_update" "identified" {
+  status_page_subdomain = "example-com"
+  incident_id           = "1"
+  incident_update = {
+    state   = "monitoring"
+    message = "A fix is deployed, we are monitoring the results."
+  }
+}
+
+resource "statuspal_incident_update" "resolved" {
+  status_page_subdomain = "example-com"
+  incident_id           = "1"
+  incident_update = {
+    state   = "resolved"
+    message = "The issue is resolved."
+  }
+
+  depends_on = [statuspal_incident_update.identified]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("statuspal_incident_update.identified", "incident_update.id", "11"),
+					resource.TestCheckResourceAttr("statuspal_incident_update.identified", "incident_update.state", "monitoring"),
+					resource.TestCheckResourceAttr("statuspal_incident_update.identified", "incident_update.message", "A fix is deployed, we are monitoring the results."),
+					resource.TestCheckNoResourceAttr("statuspal_incident_update.identified", "incident_update.translations"),
+					resource.TestCheckNoResourceAttr("statuspal_incident_update.resolved", "incident_update.type"),
+					func(_ *terraform.State) error {
+						for _, updateRequest := range updateRequests {
+							if update := updateRequest.IncidentUpdate; update.Subtype == "resolved" && update.Type != "resolve" {
+								return fmt.Errorf("expected the resolved update to fall back to the resolve type, got: %+v", update)
+							}
+						}
+						return nil
+					},
+				),
+			},
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			if deleted.Load() != 2 {
+				return fmt.Errorf("expected the incident updates to be deleted")
+			}
+			return nil
+		},
+	})
+}
@@ -0,0 +1,117 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	statuspal "terraform-provider-statuspal/internal/client"
+)
+
+// mapInitialUpdateToRequestBody maps the message of an incident, held by its
+// initial update, and the translations of the incident title and message.
+// The messageAttribute is the name of the translated message attribute, which
+// is "description" for the maintenances.
+func mapInitialUpdateToRequestBody(
+	ctx context.Context,
+	updateType string,
+	message types.String,
+	initialUpdateID types.String,
+	translations types.Map,
+	messageAttribute string,
+	diagnostics *diag.Diagnostics,
+) (statuspal.IncidentUpdate, statuspal.IncidentTranslations) {
+	initialUpdate := statuspal.IncidentUpdate{
+		Type:         updateType,
+		Description:  message.ValueString(),
+		Translations: statuspal.IncidentUpdateTranslations{},
+	}
+	if id := initialUpdateID.ValueString(); id != "" {
+		parsedID, err := strconv.ParseInt(id, 10, 64)
+		if err != nil {
+			diagnostics.AddError("Not valid incident update ID", err.Error())
+
+			return initialUpdate, nil
+		}
+
+		initialUpdate.ID = parsedID
+	}
+
+	translationData := make(statuspal.IncidentTranslations)
+	if !translations.IsNull() && !translations.IsUnknown() {
+		translationObjects := make(map[string]types.Object, len(translations.Elements()))
+		diagnostics.Append(translations.ElementsAs(ctx, &translationObjects, false)...)
+		if diagnostics.HasError() {
+			return initialUpdate, nil
+		}
+
+		for lang, translation := range translationObjects {
+			attributes := translation.Attributes()
+			title, _ := attributes["title"].(types.String)
+			message, _ := attributes[messageAttribute].(types.String)
+
+			translationData[lang] = statuspal.IncidentTranslation{
+				Title: title.ValueString(),
+			}
+			initialUpdate.Translations[lang] = statuspal.IncidentUpdateTranslation{
+				Description: message.ValueString(),
+			}
+		}
+	}
+
+	return initialUpdate, translationData
+}
+
+// mapResponseToInitialUpdate maps the initial update of an incident returned
+// by the API, the oldest one, to the message, its ID and the translations of
+// the incident title and message. The previous message is kept when the
+// incident has no update.
+func mapResponseToInitialUpdate(
+	incident *statuspal.Incident,
+	previousMessage types.String,
+	translationAttrTypes map[string]attr.Type,
+	messageAttribute string,
+	diagnostics *diag.Diagnostics,
+) (types.String, types.String, types.Map) {
+	var initialUpdate *statuspal.IncidentUpdate
+	for i, update := range incident.Updates {
+		if initialUpdate == nil || update.ID < initialUpdate.ID {
+			initialUpdate = &incident.Updates[i]
+		}
+	}
+
+	message := previousMessage
+	initialUpdateID := types.StringNull()
+	updateTranslations := statuspal.IncidentUpdateTranslations{}
+	if initialUpdate != nil {
+		message = types.StringValue(initialUpdate.Description)
+		initialUpdateID = types.StringValue(strconv.FormatInt(initialUpdate.ID, 10))
+		updateTranslations = initialUpdate.Translations
+	}
+
+	translationType := types.ObjectType{AttrTypes: translationAttrTypes}
+	if len(incident.Translations) == 0 {
+		return message, initialUpdateID, types.MapNull(translationType)
+	}
+
+	translationData := make(map[string]attr.Value, len(incident.Translations))
+	for lang, data := range incident.Translations {
+		translationObject, diags := types.ObjectValue(
+			translationAttrTypes,
+			map[string]attr.Value{
+				"title":          types.StringValue(data.Title),
+				messageAttribute: types.StringValue(updateTranslations[lang].Description),
+			},
+		)
+		diagnostics.Append(diags...)
+		translationData[lang] = translationObject
+	}
+
+	translations, diags := types.MapValue(translationType, translationData)
+	diagnostics.Append(diags...)
+
+	return message, initialUpdateID, translations
+}
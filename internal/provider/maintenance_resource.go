@@ -0,0 +1,487 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	statuspal "terraform-provider-statuspal/internal/client"
+)
+
+// maintenanceIncidentType is the type of the incidents which are scheduled maintenances.
+const maintenanceIncidentType = "scheduled"
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &maintenanceResource{}
+	_ resource.ResourceWithConfigure      = &maintenanceResource{}
+	_ resource.ResourceWithImportState    = &maintenanceResource{}
+	_ resource.ResourceWithValidateConfig = &maintenanceResource{}
+)
+
+// NewMaintenanceResource is a helper function to simplify the provider implementation.
+func NewMaintenanceResource() resource.Resource {
+	return &maintenanceResource{}
+}
+
+// maintenanceResource is the resource implementation.
+type maintenanceResource struct {
+	client *statuspal.Client
+}
+
+// maintenanceResourceModel maps the resource schema data.
+type maintenanceResourceModel struct {
+	ID                  types.String     `tfsdk:"id"` // only for test case
+	StatusPageSubdomain types.String     `tfsdk:"status_page_subdomain"`
+	Maintenance         maintenanceModel `tfsdk:"maintenance"`
+}
+
+// maintenanceModel maps maintenance schema data.
+type maintenanceModel struct {
+	ID              types.String `tfsdk:"id"`
+	Title           types.String `tfsdk:"title"`
+	Description     types.String `tfsdk:"description"`
+	InitialUpdateID types.String `tfsdk:"initial_update_id"`
+	ServiceIDs      types.List   `tfsdk:"service_ids"`
+	StartsAt        types.String `tfsdk:"starts_at"`
+	EndsAt          types.String `tfsdk:"ends_at"`
+	Notify          types.Bool   `tfsdk:"notify"`
+	Tweet           types.Bool   `tfsdk:"tweet"`
+	Translations    types.Map    `tfsdk:"translations"`
+	Url             types.String `tfsdk:"url"`
+	InsertedAt      types.String `tfsdk:"inserted_at"`
+	UpdatedAt       types.String `tfsdk:"updated_at"`
+}
+
+// maintenanceTranslationAttrTypes is the type of the maintenance translations.
+var maintenanceTranslationAttrTypes = map[string]attr.Type{
+	"title":       types.StringType,
+	"description": types.StringType,
+}
+
+// Metadata returns the resource type name.
+func (r *maintenanceResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_maintenance"
+}
+
+// Schema defines the schema for the resource.
+func (r *maintenanceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a scheduled maintenance of the status page.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier attribute. Ignore it, only used in testing.",
+				Computed:    true,
+			},
+			"status_page_subdomain": schema.StringAttribute{
+				Description: "The status page's subdomain where the maintenance belong.",
+				Required:    true,
+			},
+			"maintenance": schema.SingleNestedAttribute{
+				Description: "The scheduled maintenance.",
+				Required:    true,
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Description: "The ID of the maintenance.",
+						Computed:    true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"title": schema.StringAttribute{
+						Description: "The title of the maintenance.",
+						Required:    true,
+					},
+					"description": schema.StringAttribute{
+						Description: "The description of the maintenance, posted as its initial update.",
+						Required:    true,
+					},
+					"initial_update_id": schema.StringAttribute{
+						Description: "The ID of the initial update of the maintenance, holding the description.",
+						Computed:    true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"service_ids": schema.ListAttribute{
+						Description: "IDs of the services affected by the maintenance.",
+						Optional:    true,
+						Computed:    true,
+						ElementType: types.StringType,
+						Default:     listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{})),
+					},
+					"starts_at": schema.StringAttribute{
+						MarkdownDescription: "Datetime at which the maintenance starts, e.g. `2024-05-16T22:00:00Z`.",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(dateTimeRegexp, "must be an ISO 8601 date time, e.g. 2024-05-16T22:00:00Z"),
+						},
+					},
+					"ends_at": schema.StringAttribute{
+						MarkdownDescription: "Datetime at which the maintenance ends, e.g. `2024-05-17T02:00:00Z`. It must be after `starts_at`.",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(dateTimeRegexp, "must be an ISO 8601 date time, e.g. 2024-05-17T02:00:00Z"),
+						},
+					},
+					"notify": schema.BoolAttribute{
+						MarkdownDescription: "Notify the status page subscribers about the maintenance? " +
+							"Reminders are then sent according to the `maintenance_notification_hours` of the status page. Defaults to `false`.",
+						Optional: true,
+						Computed: true,
+						Default:  booldefault.StaticBool(false),
+					},
+					"tweet": schema.BoolAttribute{
+						MarkdownDescription: "Tweet about the maintenance? Defaults to `false`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
+					"translations": schema.MapNestedAttribute{
+						MarkdownDescription: "A translations object. For example:\n  ```terraform" + `
+	{
+		fr = {
+			title = "Maintenance de la base de données"
+			description = "Nous mettons à jour notre base de données..."
+		}
+	}
+` + "  ```\n→ ",
+						Optional: true,
+						Computed: true,
+						Default:  mapdefault.StaticValue(types.MapNull(types.ObjectType{AttrTypes: maintenanceTranslationAttrTypes})),
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"title": schema.StringAttribute{
+									Description: "The title of the maintenance.",
+									Required:    true,
+								},
+								"description": schema.StringAttribute{
+									Description: "The description of the maintenance.",
+									Required:    true,
+								},
+							},
+						},
+					},
+					"url": schema.StringAttribute{
+						Description: "The URL of the maintenance on the status page.",
+						Computed:    true,
+					},
+					"inserted_at": schema.StringAttribute{
+						Description: "Datetime at which the maintenance was inserted.",
+						Computed:    true,
+					},
+					"updated_at": schema.StringAttribute{
+						Description: "Datetime at which the maintenance was last updated.",
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that the maintenance ends after it starts.
+func (r *maintenanceResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	validateEndsAfterStarts(ctx, req.Config, path.Root("maintenance"),
+		"Invalid StatusPal Maintenance End Time",
+		"The maintenance must end after it starts",
+		&resp.Diagnostics,
+	)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *maintenanceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan maintenanceResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	maintenance := mapMaintenanceModelToRequestBody(ctx, &plan.Maintenance, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create new maintenance
+	statusPageSubdomain := plan.StatusPageSubdomain.ValueString()
+	newMaintenance, err := r.client.CreateIncident(ctx, maintenance, &statusPageSubdomain)
+	if err != nil {
+		addClientError(&resp.Diagnostics, path.Root("maintenance"),
+			"Error creating StatusPal Maintenance",
+			"Could not create maintenance, unexpected error: ",
+			err,
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	newMaintenanceModel := mapResponseToMaintenanceModel(ctx, newMaintenance, &plan.Maintenance, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.Maintenance = *newMaintenanceModel
+	plan.ID = types.StringValue("placeholder") // only for test case
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *maintenanceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state maintenanceResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed maintenance value from StatusPal
+	statusPageSubdomain := state.StatusPageSubdomain.ValueString()
+	maintenanceID := state.Maintenance.ID.ValueString()
+	maintenance, err := r.client.GetIncident(ctx, &statusPageSubdomain, &maintenanceID)
+	if statuspal.ErrorNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading StatusPal Maintenance",
+			"Could not read maintenance ID "+maintenanceID+": "+err.Error(),
+		)
+		return
+	}
+
+	if maintenance.Type != maintenanceIncidentType {
+		resp.Diagnostics.AddError(
+			"Error Reading StatusPal Maintenance",
+			fmt.Sprintf("The incident ID %s is not a scheduled maintenance but a %q incident, manage it with the statuspal_incident resource.", maintenanceID, maintenance.Type),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	maintenanceModel := mapResponseToMaintenanceModel(ctx, maintenance, &state.Maintenance, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Maintenance = *maintenanceModel
+	state.ID = types.StringValue("placeholder") // only for test case
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *maintenanceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan maintenanceResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	maintenance := mapMaintenanceModelToRequestBody(ctx, &plan.Maintenance, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update existing maintenance
+	statusPageSubdomain := plan.StatusPageSubdomain.ValueString()
+	maintenanceID := plan.Maintenance.ID.ValueString()
+	updatedMaintenance, err := r.client.UpdateIncident(ctx, maintenance, &statusPageSubdomain, &maintenanceID)
+	if err != nil {
+		addClientError(&resp.Diagnostics, path.Root("maintenance"),
+			"Error Updating StatusPal Maintenance",
+			"Could not Update maintenance, unexpected error: ",
+			err,
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	updatedMaintenanceModel := mapResponseToMaintenanceModel(ctx, updatedMaintenance, &plan.Maintenance, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.Maintenance = *updatedMaintenanceModel
+	plan.ID = types.StringValue("placeholder") // only for test case
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *maintenanceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state maintenanceResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing maintenance
+	statusPageSubdomain := state.StatusPageSubdomain.ValueString()
+	maintenanceID := state.Maintenance.ID.ValueString()
+	err := r.client.DeleteIncident(ctx, &statusPageSubdomain, &maintenanceID)
+	if err != nil && !statuspal.ErrorNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting StatusPal Maintenance",
+			"Could not delete maintenance, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *maintenanceResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	// Split the ID based on the delimiter used during import
+	parts := strings.Split(req.ID, " ")
+	if len(parts) != 2 {
+		resp.Diagnostics.AddError(
+			"Unexpected StatusPal Maintenance Import Identifier",
+			`Expected StatusPal maintenance import identifier with format: "<status_page_subdomain> <maintenance_id>"`,
+		)
+		return
+	}
+
+	req.ID = parts[0]
+	resource.ImportStatePassthroughID(ctx, path.Root("status_page_subdomain"), req, resp)
+	req.ID = parts[1]
+	resource.ImportStatePassthroughID(ctx, path.Root("maintenance").AtName("id"), req, resp)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *maintenanceResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*statuspal.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf(
+				"Expected *statuspal.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func mapMaintenanceModelToRequestBody(
+	ctx context.Context,
+	maintenance *maintenanceModel,
+	diagnostics *diag.Diagnostics,
+) *statuspal.Incident {
+	serviceIDs := parseServiceIDs(ctx, maintenance.ServiceIDs, diagnostics)
+	if diagnostics.HasError() {
+		return nil
+	}
+
+	// The description and its translations are held by the initial update of the maintenance
+	initialUpdate, translationData := mapInitialUpdateToRequestBody(
+		ctx, maintenanceIncidentType, maintenance.Description, maintenance.InitialUpdateID, maintenance.Translations, "description", diagnostics,
+	)
+	if diagnostics.HasError() {
+		return nil
+	}
+
+	endsAt := maintenance.EndsAt.ValueString()
+
+	return &statuspal.Incident{
+		Title:        maintenance.Title.ValueString(),
+		Type:         maintenanceIncidentType,
+		StartsAt:     maintenance.StartsAt.ValueString(),
+		EndsAt:       &endsAt,
+		ServiceIDs:   serviceIDs,
+		Updates:      []statuspal.IncidentUpdate{initialUpdate},
+		Translations: translationData,
+		Notify:       maintenance.Notify.ValueBool(),
+		Tweet:        maintenance.Tweet.ValueBool(),
+	}
+}
+
+// mapResponseToMaintenanceModel maps the maintenance returned by the API, the
+// same way as mapResponseToIncidentModel.
+func mapResponseToMaintenanceModel(
+	ctx context.Context,
+	maintenance *statuspal.Incident,
+	previous *maintenanceModel,
+	diagnostics *diag.Diagnostics,
+) *maintenanceModel {
+	description, initialUpdateID, translations := mapResponseToInitialUpdate(
+		maintenance, previous.Description, maintenanceTranslationAttrTypes, "description", diagnostics,
+	)
+	if diagnostics.HasError() {
+		return nil
+	}
+
+	serviceIDs := mapServiceIDsToList(ctx, previous.ServiceIDs, maintenance.ServiceIDs, diagnostics)
+	if diagnostics.HasError() {
+		return nil
+	}
+
+	return &maintenanceModel{
+		ID:              types.StringValue(strconv.FormatInt(maintenance.ID, 10)),
+		Title:           types.StringValue(maintenance.Title),
+		Description:     description,
+		InitialUpdateID: initialUpdateID,
+		ServiceIDs:      serviceIDs,
+		StartsAt:        dateTimeValue(previous.StartsAt, maintenance.StartsAt),
+		EndsAt:          optionalDateTimeValue(previous.EndsAt, maintenance.EndsAt),
+		Notify:          types.BoolValue(previous.Notify.ValueBool()),
+		Tweet:           types.BoolValue(previous.Tweet.ValueBool()),
+		Translations:    translations,
+		Url:             types.StringValue(maintenance.Url),
+		InsertedAt:      types.StringValue(maintenance.InsertedAt),
+		UpdatedAt:       types.StringValue(maintenance.UpdatedAt),
+	}
+}
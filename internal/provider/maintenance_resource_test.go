@@ -0,0 +1,203 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	statuspal "terraform-provider-statuspal/internal/client"
+)
+
+func TestAccMaintenanceResource(t *testing.T) {
+	responseBody := `{
+		"incident": {
+			"id": 1,
+			"title": "Database upgrade",
+			"type": "scheduled",
+			"starts_at": "2024-05-16T22:00:00",
+			"ends_at": "2024-05-17T02:00:00",
+			"service_ids": [2],
+			"updates": [
+				{
+					"id": 10,
+					"type": "scheduled",
+					"description": "We are upgrading our database.",
+					"translations": {"fr": {"description": "Nous mettons à jour notre base de données."}}
+				}
+			],
+			"translations": {"fr": {"title": "Mise à jour de la base de données"}},
+			"notify": false,
+			"tweet": false,
+			"url": "https://example-com.statuspal.io/incidents/1",
+			"inserted_at": "2024-05-16T10:00:00",
+			"updated_at": "2024-05-16T10:00:00"
+		}
+	}`
+	updatedResponseBody := `{
+		"incident": {
+			"id": 1,
+			"title": "Database upgrade",
+			"type": "scheduled",
+			"starts_at": "2024-05-16T23:00:00",
+			"ends_at": "2024-05-17T03:00:00",
+			"service_ids": [],
+			"updates": [
+				{"id": 10, "type": "scheduled", "description": "We are upgrading our database, the API may be slower.", "translations": {}}
+			],
+			"translations": {},
+			"notify": false,
+			"tweet": false,
+			"url": "https://example-com.statuspal.io/incidents/1",
+			"inserted_at": "2024-05-16T10:00:00",
+			"updated_at": "2024-05-16T11:00:00"
+		}
+	}`
+
+	var createRequest statuspal.IncidentResponse
+	var updated atomic.Bool
+	var deleted atomic.Int32
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /status_pages/example-com/incidents", func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&createRequest); err != nil {
+			http.Error(w, fmt.Sprintf("Failed to decode JSON: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		if _, err := w.Write([]byte(responseBody)); err != nil {
+			log.Printf(`Error writing "/status_pages/example-com/incidents" response: %v`, err)
+		}
+	})
+	mux.HandleFunc("/status_pages/example-com/incidents/1", func(w http.ResponseWriter, r *http.Request) {
+		body := responseBody
+		if updated.Load() {
+			body = updatedResponseBody
+		}
+
+		switch r.Method {
+		case http.MethodPut:
+			updated.Store(true)
+			body = updatedResponseBody
+		case http.MethodDelete:
+			deleted.Add(1)
+			body = `""`
+		}
+
+		if _, err := w.Write([]byte(body)); err != nil {
+			log.Printf(`Error writing "/status_pages/example-com/incidents/1" response with method "%s": %v`, r.Method, err)
+		}
+	})
+	mock := httptest.NewServer(mux)
+	defer mock.Close()
+
+	providerConfig := *providerConfig(&mock.URL)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// End before start error testing
+			{
+				Config: providerConfig + `
+resource "statuspal_maintenance" "test" {
+  status_page_subdomain = "example-com"
+  maintenance = {
+    title       = "Database upgrade"
+    description = "We are upgrading our database."
+    starts_at   = "2024-05-17T00:00:00+02:00"
+    ends_at     = "2024-05-16T22:00:00Z"
+  }
+}
+`,
+				ExpectError: regexp.MustCompile(`The maintenance must end after it starts`),
+			},
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "statuspal_maintenance" "test" {
+  status_page_subdomain = "example-com"
+  maintenance = {
+    title       = "Database upgrade"
+    description = "We are upgrading our database."
+    service_ids = ["2"]
+    starts_at   = "2024-05-16T22:00:00"
+    ends_at     = "2024-05-17T02:00:00"
+    notify      = true
+    translations = {
+      fr = {
+        title       = "Mise à jour de la base de données"
+        description = "Nous mettons à jour notre base de données."
+      }
+    }
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("statuspal_maintenance.test", "status_page_subdomain", "example-com"),
+					resource.TestCheckResourceAttr("statuspal_maintenance.test", "maintenance.id", "1"),
+					resource.TestCheckResourceAttr("statuspal_maintenance.test", "maintenance.title", "Database upgrade"),
+					resource.TestCheckResourceAttr("statuspal_maintenance.test", "maintenance.description", "We are upgrading our database."),
+					resource.TestCheckResourceAttr("statuspal_maintenance.test", "maintenance.initial_update_id", "10"),
+					resource.TestCheckResourceAttr("statuspal_maintenance.test", "maintenance.service_ids.#", "1"),
+					resource.TestCheckResourceAttr("statuspal_maintenance.test", "maintenance.starts_at", "2024-05-16T22:00:00"),
+					resource.TestCheckResourceAttr("statuspal_maintenance.test", "maintenance.ends_at", "2024-05-17T02:00:00"),
+					resource.TestCheckResourceAttr("statuspal_maintenance.test", "maintenance.notify", "true"),
+					resource.TestCheckResourceAttr("statuspal_maintenance.test", "maintenance.tweet", "false"),
+					resource.TestCheckResourceAttr("statuspal_maintenance.test", "maintenance.translations.fr.title", "Mise à jour de la base de données"),
+					resource.TestCheckResourceAttr("statuspal_maintenance.test", "maintenance.translations.fr.description", "Nous mettons à jour notre base de données."),
+					resource.TestCheckResourceAttr("statuspal_maintenance.test", "maintenance.url", "https://example-com.statuspal.io/incidents/1"),
+					func(_ *terraform.State) error {
+						maintenance := createRequest.Incident
+						if maintenance.Type != "scheduled" || len(maintenance.Updates) != 1 || maintenance.Updates[0].Type != "scheduled" {
+							return fmt.Errorf("unexpected maintenance creation request: %+v", maintenance)
+						}
+						return nil
+					},
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "statuspal_maintenance.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     "example-com 1",
+				// The notify flag is not returned by the API.
+				ImportStateVerifyIgnore: []string{"maintenance.notify"},
+			},
+			// Update and Read testing, the date times are written with a time zone
+			{
+				Config: providerConfig + `
+resource "statuspal_maintenance" "test" {
+  status_page_subdomain = "example-com"
+  maintenance = {
+    title       = "Database upgrade"
+    description = "We are upgrading our database, the API may be slower."
+    starts_at   = "2024-05-16T23:00:00Z"
+    ends_at     = "2024-05-17T03:00:00Z"
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("statuspal_maintenance.test", "maintenance.description", "We are upgrading our database, the API may be slower."),
+					resource.TestCheckResourceAttr("statuspal_maintenance.test", "maintenance.initial_update_id", "10"),
+					resource.TestCheckResourceAttr("statuspal_maintenance.test", "maintenance.service_ids.#", "0"),
+					resource.TestCheckResourceAttr("statuspal_maintenance.test", "maintenance.starts_at", "2024-05-16T23:00:00Z"),
+					resource.TestCheckResourceAttr("statuspal_maintenance.test", "maintenance.ends_at", "2024-05-17T03:00:00Z"),
+					resource.TestCheckNoResourceAttr("statuspal_maintenance.test", "maintenance.translations"),
+				),
+			},
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			if deleted.Load() != 1 {
+				return fmt.Errorf("expected the maintenance to be deleted")
+			}
+			return nil
+		},
+	})
+}
@@ -0,0 +1,317 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	statuspal "terraform-provider-statuspal/internal/client"
+)
+
+// percentMetricUnit is the unit of the metrics whose values are percentages.
+const percentMetricUnit = "%"
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource               = &metricEntryResource{}
+	_ resource.ResourceWithConfigure  = &metricEntryResource{}
+	_ resource.ResourceWithModifyPlan = &metricEntryResource{}
+)
+
+// NewMetricEntryResource is a helper function to simplify the provider implementation.
+func NewMetricEntryResource() resource.Resource {
+	return &metricEntryResource{}
+}
+
+// metricEntryResource is the resource implementation.
+type metricEntryResource struct {
+	client *statuspal.Client
+}
+
+// metricEntryResourceModel maps the resource schema data.
+type metricEntryResourceModel struct {
+	ID                  types.String       `tfsdk:"id"` // only for test case
+	StatusPageSubdomain types.String       `tfsdk:"status_page_subdomain"`
+	MetricID            types.String       `tfsdk:"metric_id"`
+	Entries             []metricEntryModel `tfsdk:"entries"`
+}
+
+// metricEntryModel maps metric entry schema data.
+type metricEntryModel struct {
+	Timestamp types.String  `tfsdk:"timestamp"`
+	Value     types.Float64 `tfsdk:"value"`
+}
+
+// Metadata returns the resource type name.
+func (r *metricEntryResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_metric_entry"
+}
+
+// Schema defines the schema for the resource.
+func (r *metricEntryResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Submits timestamped data points to a metric of the status page. " +
+			"The data points can't be changed once submitted: changing any attribute submits the new data points, " +
+			"and destroying the resource only removes it from the Terraform state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier attribute. Ignore it, only used in testing.",
+				Computed:    true,
+			},
+			"status_page_subdomain": schema.StringAttribute{
+				Description: "The status page subdomain of the metric.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"metric_id": schema.StringAttribute{
+				Description: "The ID of the metric the data points are submitted to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"entries": schema.ListNestedAttribute{
+				MarkdownDescription: "The data points. The values of the `up` metrics and of the metrics with the `%` unit must be " +
+					"percentages between 0 and 100, the values of the `rt` metrics must not be negative.",
+				Required: true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"timestamp": schema.StringAttribute{
+							Description: "Datetime of the data point, e.g. 2024-05-16T10:00:00Z.",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(dateTimeRegexp, "must be a valid ISO 8601 datetime"),
+							},
+						},
+						"value": schema.Float64Attribute{
+							Description: "Value of the data point, in the unit of the metric.",
+							Required:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ModifyPlan checks the data points against the unit and type of the metric.
+func (r *metricEntryResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when the resource is destroyed or the provider is not configured yet
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	// Nor when the data points don't change, only the created ones are checked
+	if !req.State.Raw.IsNull() && req.Plan.Raw.Equal(req.State.Raw) {
+		return
+	}
+
+	var plan metricEntryResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.StatusPageSubdomain.IsUnknown() || plan.MetricID.IsUnknown() {
+		return
+	}
+
+	r.checkEntries(ctx, &plan, &resp.Diagnostics)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *metricEntryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan metricEntryResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Check again the data points, the metric may have been unknown during the plan
+	entries := r.checkEntries(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Submit the data points
+	err := r.client.CreateMetricEntries(ctx, plan.MetricID.ValueString(), plan.StatusPageSubdomain.ValueString(), entries)
+	if err != nil {
+		addClientError(&resp.Diagnostics, path.Root("entries"),
+			"Error creating StatusPal Metric Entries",
+			"Could not submit the metric entries, unexpected error: ",
+			err,
+		)
+		return
+	}
+
+	plan.ID = types.StringValue("placeholder") // only for test case
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *metricEntryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state metricEntryResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The data points can't be read back, only check the metric still exists
+	metricID := state.MetricID.ValueString()
+	_, err := r.client.GetMetric(ctx, metricID, state.StatusPageSubdomain.ValueString())
+	if statuspal.ErrorNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading StatusPal Metric",
+			"Could not read metric ID "+metricID+": "+err.Error(),
+		)
+		return
+	}
+}
+
+// Update is never called, all the attributes require the resource to be replaced.
+func (r *metricEntryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan metricEntryResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete removes the resource from the Terraform state, the submitted data points are kept.
+func (r *metricEntryResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *metricEntryResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*statuspal.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf(
+				"Expected *statuspal.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// checkEntries fetches the metric of the data points, checks their values
+// against its unit and type, and returns the data points to submit.
+func (r *metricEntryResource) checkEntries(
+	ctx context.Context,
+	plan *metricEntryResourceModel,
+	diagnostics *diag.Diagnostics,
+) []statuspal.MetricEntry {
+	metricID := plan.MetricID.ValueString()
+	metric, err := r.client.GetMetric(ctx, metricID, plan.StatusPageSubdomain.ValueString())
+	if statuspal.ErrorNotFound(err) {
+		diagnostics.AddAttributeError(
+			path.Root("metric_id"),
+			"StatusPal Metric Not Found",
+			fmt.Sprintf("The metric ID %s doesn't exist in the status page %q.", metricID, plan.StatusPageSubdomain.ValueString()),
+		)
+		return nil
+	}
+	if err != nil {
+		diagnostics.AddError(
+			"Error Reading StatusPal Metric",
+			"Could not read metric ID "+metricID+": "+err.Error(),
+		)
+		return nil
+	}
+
+	entries := make([]statuspal.MetricEntry, 0, len(plan.Entries))
+	for i, entry := range plan.Entries {
+		// Unknown values are checked again on apply
+		if entry.Timestamp.IsUnknown() || entry.Value.IsUnknown() {
+			continue
+		}
+
+		timestamp, err := parseDateTime(entry.Timestamp.ValueString())
+		if err != nil {
+			diagnostics.AddAttributeError(
+				path.Root("entries").AtListIndex(i).AtName("timestamp"),
+				"Invalid StatusPal Metric Entry Timestamp",
+				fmt.Sprintf("The timestamp %q of the metric entry must be a valid ISO 8601 datetime.", entry.Timestamp.ValueString()),
+			)
+			continue
+		}
+
+		value := entry.Value.ValueFloat64()
+		if detail := metricEntryValueError(metric, value); detail != "" {
+			diagnostics.AddAttributeError(
+				path.Root("entries").AtListIndex(i).AtName("value"),
+				"Invalid StatusPal Metric Entry Value",
+				detail,
+			)
+			continue
+		}
+
+		entries = append(entries, statuspal.MetricEntry{Timestamp: timestamp.Unix(), Value: value})
+	}
+
+	return entries
+}
+
+// metricEntryValueError returns the detail of the error when the value doesn't
+// fit the unit and type of the metric, or an empty string when it does.
+func metricEntryValueError(metric *statuspal.Metric, value float64) string {
+	switch {
+	case metric.Type == UptimeMetric && (value < 0 || value > 100):
+		return fmt.Sprintf("The value %v of the %q uptime metric must be a percentage between 0 and 100.", value, metric.Title)
+	case metric.Unit == percentMetricUnit && (value < 0 || value > 100):
+		return fmt.Sprintf("The value %v of the %q metric, measured in %s, must be between 0 and 100.", value, metric.Title, metric.Unit)
+	case metric.Type == ResponseTimeMetric && value < 0:
+		return fmt.Sprintf("The value %v of the %q response time metric, measured in %s, must not be negative.", value, metric.Title, metric.Unit)
+	}
+
+	return ""
+}
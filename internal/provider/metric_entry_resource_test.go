@@ -0,0 +1,117 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	statuspal "terraform-provider-statuspal/internal/client"
+)
+
+func TestAccMetricEntryResource(t *testing.T) {
+	var entries []statuspal.MetricEntry
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /status_pages/example-com/metrics/1", func(w http.ResponseWriter, r *http.Request) {
+		if _, err := w.Write([]byte(`{"metric": {"id": 1, "title": "Uptime", "unit": "%", "type": "up"}}`)); err != nil {
+			log.Printf(`Error writing "/status_pages/example-com/metrics/1" response: %v`, err)
+		}
+	})
+	mux.HandleFunc("GET /status_pages/example-com/metrics/2", func(w http.ResponseWriter, r *http.Request) {
+		if _, err := w.Write([]byte(`{"metric": {"id": 2, "title": "Response time", "unit": "ms", "type": "rt"}}`)); err != nil {
+			log.Printf(`Error writing "/status_pages/example-com/metrics/2" response: %v`, err)
+		}
+	})
+	mux.HandleFunc("POST /status_pages/example-com/metrics/2/entries", func(w http.ResponseWriter, r *http.Request) {
+		var request statuspal.MetricEntriesBody
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			http.Error(w, fmt.Sprintf("Failed to decode JSON: %v", err), http.StatusBadRequest)
+			return
+		}
+		entries = append(entries, request.Entries...)
+
+		if _, err := w.Write([]byte(`""`)); err != nil {
+			log.Printf(`Error writing "/status_pages/example-com/metrics/2/entries" response: %v`, err)
+		}
+	})
+	mock := httptest.NewServer(mux)
+	defer mock.Close()
+
+	providerConfig := *providerConfig(&mock.URL)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Uptime out of range error testing
+			{
+				Config: providerConfig + `
+resource "statuspal_metric_entry" "test" {
+  status_page_subdomain = "example-com"
+  metric_id             = "1"
+  entries = [
+    { timestamp = "2024-05-16T10:00:00Z", value = 101 },
+  ]
+}
+`,
+				ExpectError: regexp.MustCompile(`must be a percentage between 0 and 100`),
+			},
+			// Negative response time error testing
+			{
+				Config: providerConfig + `
+resource "statuspal_metric_entry" "test" {
+  status_page_subdomain = "example-com"
+  metric_id             = "2"
+  entries = [
+    { timestamp = "2024-05-16T10:00:00Z", value = -1 },
+  ]
+}
+`,
+				ExpectError: regexp.MustCompile(`must not be negative`),
+			},
+			// Unknown metric error testing
+			{
+				Config: providerConfig + `
+resource "statuspal_metric_entry" "test" {
+  status_page_subdomain = "example-com"
+  metric_id             = "3"
+  entries = [
+    { timestamp = "2024-05-16T10:00:00Z", value = 1 },
+  ]
+}
+`,
+				ExpectError: regexp.MustCompile(`The metric ID 3 doesn't exist`),
+			},
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "statuspal_metric_entry" "test" {
+  status_page_subdomain = "example-com"
+  metric_id             = "2"
+  entries = [
+    { timestamp = "2024-05-16T10:00:00Z", value = 120 },
+    { timestamp = "2024-05-16T12:01:00+02:00", value = 95.5 },
+  ]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("statuspal_metric_entry.test", "metric_id", "2"),
+					resource.TestCheckResourceAttr("statuspal_metric_entry.test", "entries.#", "2"),
+					resource.TestCheckResourceAttr("statuspal_metric_entry.test", "entries.1.value", "95.5"),
+					func(_ *terraform.State) error {
+						expected := []statuspal.MetricEntry{{Timestamp: 1715853600, Value: 120}, {Timestamp: 1715853660, Value: 95.5}}
+						if fmt.Sprint(entries) != fmt.Sprint(expected) {
+							return fmt.Errorf("expected the entries %v to be submitted, got: %v", expected, entries)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
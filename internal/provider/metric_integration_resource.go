@@ -0,0 +1,389 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	statuspal "terraform-provider-statuspal/internal/client"
+)
+
+// metricIntegrationTypes are the monitoring services the metrics can pull their data from.
+var metricIntegrationTypes = []string{
+	"datadog",
+	"new_relic",
+	"pingdom",
+	"uptime_robot",
+	"statuscake",
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &metricIntegrationResource{}
+	_ resource.ResourceWithConfigure   = &metricIntegrationResource{}
+	_ resource.ResourceWithImportState = &metricIntegrationResource{}
+)
+
+// NewMetricIntegrationResource is a helper function to simplify the provider implementation.
+func NewMetricIntegrationResource() resource.Resource {
+	return &metricIntegrationResource{}
+}
+
+// metricIntegrationResource is the resource implementation.
+type metricIntegrationResource struct {
+	client *statuspal.Client
+}
+
+// metricIntegrationResourceModel maps the resource schema data.
+type metricIntegrationResourceModel struct {
+	ID                  types.String           `tfsdk:"id"` // only for test case
+	StatusPageSubdomain types.String           `tfsdk:"status_page_subdomain"`
+	MetricIntegration   metricIntegrationModel `tfsdk:"metric_integration"`
+}
+
+// metricIntegrationModel maps metric integration schema data.
+type metricIntegrationModel struct {
+	ID                 types.String `tfsdk:"id"`
+	Type               types.String `tfsdk:"type"`
+	Name               types.String `tfsdk:"name"`
+	Credentials        types.Map    `tfsdk:"credentials"`
+	CredentialsVersion types.Int64  `tfsdk:"credentials_version"`
+	InsertedAt         types.String `tfsdk:"inserted_at"`
+	UpdatedAt          types.String `tfsdk:"updated_at"`
+}
+
+// Metadata returns the resource type name.
+func (r *metricIntegrationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_metric_integration"
+}
+
+// Schema defines the schema for the resource.
+func (r *metricIntegrationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the credentials of a monitoring service the metrics of the status page pull their data from. " +
+			"Reference it from the `integration_id` attribute of a `statuspal_metric` with `tonumber(statuspal_metric_integration.example.metric_integration.id)`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier attribute. Ignore it, only used in testing.",
+				Computed:    true,
+			},
+			"status_page_subdomain": schema.StringAttribute{
+				Description: "The status page's subdomain where the metric integration belong.",
+				Required:    true,
+			},
+			"metric_integration": schema.SingleNestedAttribute{
+				Description: "The metric integration.",
+				Required:    true,
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Description: "The ID of the metric integration.",
+						Computed:    true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"type": schema.StringAttribute{
+						MarkdownDescription: "The monitoring service of the integration. Enum: " + quotedValuesDescription(metricIntegrationTypes) + ".",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(metricIntegrationTypes...),
+						},
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+					"name": schema.StringAttribute{
+						Description: "The name of the metric integration.",
+						Required:    true,
+					},
+					"credentials": schema.MapAttribute{
+						MarkdownDescription: "The credentials of the monitoring service, e.g. `api_key` and `application_key` for Datadog. " +
+							"They are write-only: they are only sent to StatusPal, which never returns them, and they are not stored in the Terraform state. " +
+							"They are sent when the metric integration is created and when `credentials_version` changes. Requires Terraform 1.11 or later.",
+						Required:    true,
+						Sensitive:   true,
+						WriteOnly:   true,
+						ElementType: types.StringType,
+						Validators: []validator.Map{
+							mapvalidator.SizeAtLeast(1),
+						},
+					},
+					"credentials_version": schema.Int64Attribute{
+						MarkdownDescription: "The version of the `credentials`. Change it to send the `credentials` to StatusPal again, e.g. after rotating them.",
+						Optional:            true,
+					},
+					"inserted_at": schema.StringAttribute{
+						Description: "Datetime at which the metric integration was inserted.",
+						Computed:    true,
+					},
+					"updated_at": schema.StringAttribute{
+						Description: "Datetime at which the metric integration was last updated.",
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *metricIntegrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan metricIntegrationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan, the credentials are write-only and only available in the configuration
+	integration := mapMetricIntegrationModelToRequestBody(&plan.MetricIntegration)
+	integration.Credentials = configMetricIntegrationCredentials(ctx, req.Config, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create new metric integration
+	newIntegration, err := r.client.CreateMetricIntegration(ctx, plan.StatusPageSubdomain.ValueString(), integration)
+	if err != nil {
+		addClientError(&resp.Diagnostics, path.Root("metric_integration"),
+			"Error creating StatusPal Metric Integration",
+			"Could not create metric integration, unexpected error: ",
+			err,
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.MetricIntegration = *mapResponseToMetricIntegrationModel(newIntegration, &plan.MetricIntegration)
+	plan.ID = types.StringValue("placeholder") // only for test case
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *metricIntegrationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state metricIntegrationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed metric integration value from StatusPal
+	integrationID := state.MetricIntegration.ID.ValueString()
+	integration, err := r.client.GetMetricIntegration(ctx, integrationID, state.StatusPageSubdomain.ValueString())
+	if statuspal.ErrorNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading StatusPal Metric Integration",
+			"Could not read metric integration ID "+integrationID+": "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	state.MetricIntegration = *mapResponseToMetricIntegrationModel(integration, &state.MetricIntegration)
+	state.ID = types.StringValue("placeholder") // only for test case
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *metricIntegrationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan metricIntegrationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	integration := mapMetricIntegrationModelToRequestBody(&plan.MetricIntegration)
+
+	// The API keeps the credentials when they are omitted, so the credentials of the configuration are only sent
+	// when their version changes
+	var state metricIntegrationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !plan.MetricIntegration.CredentialsVersion.Equal(state.MetricIntegration.CredentialsVersion) {
+		integration.Credentials = configMetricIntegrationCredentials(ctx, req.Config, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Update existing metric integration
+	integrationID := plan.MetricIntegration.ID.ValueString()
+	updatedIntegration, err := r.client.UpdateMetricIntegration(ctx, integrationID, plan.StatusPageSubdomain.ValueString(), integration)
+	if err != nil {
+		addClientError(&resp.Diagnostics, path.Root("metric_integration"),
+			"Error Updating StatusPal Metric Integration",
+			"Could not Update metric integration, unexpected error: ",
+			err,
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.MetricIntegration = *mapResponseToMetricIntegrationModel(updatedIntegration, &plan.MetricIntegration)
+	plan.ID = types.StringValue("placeholder") // only for test case
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *metricIntegrationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state metricIntegrationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing metric integration
+	err := r.client.DeleteMetricIntegration(ctx, state.MetricIntegration.ID.ValueString(), state.StatusPageSubdomain.ValueString())
+	if err != nil && !statuspal.ErrorNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting StatusPal Metric Integration",
+			"Could not delete metric integration, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *metricIntegrationResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	// Split the ID based on the delimiter used during import
+	parts := strings.Split(req.ID, " ")
+	if len(parts) != 2 {
+		resp.Diagnostics.AddError(
+			"Unexpected StatusPal Metric Integration Import Identifier",
+			`Expected StatusPal metric integration import identifier with format: "<status_page_subdomain> <metric_integration_id>"`,
+		)
+		return
+	}
+
+	req.ID = parts[0]
+	resource.ImportStatePassthroughID(ctx, path.Root("status_page_subdomain"), req, resp)
+	req.ID = parts[1]
+	resource.ImportStatePassthroughID(ctx, path.Root("metric_integration").AtName("id"), req, resp)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *metricIntegrationResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*statuspal.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf(
+				"Expected *statuspal.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// quotedValuesDescription returns the markdown list of the values.
+func quotedValuesDescription(values []string) string {
+	quoted := make([]string, 0, len(values))
+	for _, value := range values {
+		quoted = append(quoted, "`\""+value+"\"`")
+	}
+
+	return strings.Join(quoted, " ")
+}
+
+// configMetricIntegrationCredentials returns the write-only credentials of the configuration.
+func configMetricIntegrationCredentials(ctx context.Context, config tfsdk.Config, diagnostics *diag.Diagnostics) map[string]string {
+	var credentialsMap types.Map
+	diagnostics.Append(config.GetAttribute(ctx, path.Root("metric_integration").AtName("credentials"), &credentialsMap)...)
+	if diagnostics.HasError() {
+		return nil
+	}
+
+	credentials := map[string]string{}
+	diagnostics.Append(credentialsMap.ElementsAs(ctx, &credentials, false)...)
+	if diagnostics.HasError() {
+		return nil
+	}
+
+	return credentials
+}
+
+func mapMetricIntegrationModelToRequestBody(integration *metricIntegrationModel) *statuspal.MetricIntegration {
+	return &statuspal.MetricIntegration{
+		Type: integration.Type.ValueString(),
+		Name: integration.Name.ValueString(),
+	}
+}
+
+func mapResponseToMetricIntegrationModel(
+	integration *statuspal.MetricIntegration,
+	previous *metricIntegrationModel,
+) *metricIntegrationModel {
+	return &metricIntegrationModel{
+		ID:   types.StringValue(strconv.FormatInt(integration.ID, 10)),
+		Type: types.StringValue(integration.Type),
+		Name: types.StringValue(integration.Name),
+		// The credentials are write-only, they are never stored in the state
+		Credentials:        types.MapNull(types.StringType),
+		CredentialsVersion: previous.CredentialsVersion,
+		InsertedAt:         types.StringValue(integration.InsertedAt),
+		UpdatedAt:          types.StringValue(integration.UpdatedAt),
+	}
+}
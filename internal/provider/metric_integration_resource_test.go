@@ -0,0 +1,208 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	statuspal "terraform-provider-statuspal/internal/client"
+)
+
+func TestAccMetricIntegrationResource(t *testing.T) {
+	// The credentials are never returned by the API
+	responseBody := `{
+		"metric_integration": {
+			"id": 1,
+			"type": "datadog",
+			"name": "Datadog",
+			"inserted_at": "2024-05-16T10:00:00",
+			"updated_at": "2024-05-16T10:00:00"
+		}
+	}`
+	updatedResponseBody := `{
+		"metric_integration": {
+			"id": 1,
+			"type": "datadog",
+			"name": "Datadog EU",
+			"inserted_at": "2024-05-16T10:00:00",
+			"updated_at": "2024-05-16T11:00:00"
+		}
+	}`
+
+	// The credentials sent to StatusPal
+	var credentials []map[string]string
+	var updated atomic.Bool
+	var deleted atomic.Int32
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /status_pages/example-com/metric_integrations", func(w http.ResponseWriter, r *http.Request) {
+		var request statuspal.MetricIntegrationBody
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			http.Error(w, fmt.Sprintf("Failed to decode JSON: %v", err), http.StatusBadRequest)
+			return
+		}
+		credentials = append(credentials, request.MetricIntegration.Credentials)
+
+		if _, err := w.Write([]byte(responseBody)); err != nil {
+			log.Printf(`Error writing "/status_pages/example-com/metric_integrations" response: %v`, err)
+		}
+	})
+	mux.HandleFunc("/status_pages/example-com/metric_integrations/1", func(w http.ResponseWriter, r *http.Request) {
+		body := responseBody
+		if updated.Load() {
+			body = updatedResponseBody
+		}
+
+		switch r.Method {
+		case http.MethodPut:
+			var request statuspal.MetricIntegrationBody
+			if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+				http.Error(w, fmt.Sprintf("Failed to decode JSON: %v", err), http.StatusBadRequest)
+				return
+			}
+			credentials = append(credentials, request.MetricIntegration.Credentials)
+			updated.Store(true)
+			body = updatedResponseBody
+		case http.MethodDelete:
+			deleted.Add(1)
+			body = `""`
+		}
+
+		if _, err := w.Write([]byte(body)); err != nil {
+			log.Printf(`Error writing "/status_pages/example-com/metric_integrations/1" response with method "%s": %v`, r.Method, err)
+		}
+	})
+	mock := httptest.NewServer(mux)
+	defer mock.Close()
+
+	providerConfig := *providerConfig(&mock.URL)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Invalid type error testing
+			{
+				Config: providerConfig + `
+resource "statuspal_metric_integration" "test" {
+  status_page_subdomain = "example-com"
+  metric_integration = {
+    type        = "nagios"
+    name        = "Nagios"
+    credentials = { api_key = "key" }
+  }
+}
+`,
+				ExpectError: regexp.MustCompile(`Attribute metric_integration.type value must be one of`),
+			},
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "statuspal_metric_integration" "test" {
+  status_page_subdomain = "example-com"
+  metric_integration = {
+    type = "datadog"
+    name = "Datadog"
+    credentials = {
+      api_key         = "api-key"
+      application_key = "application-key"
+    }
+    credentials_version = 1
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("statuspal_metric_integration.test", "status_page_subdomain", "example-com"),
+					resource.TestCheckResourceAttr("statuspal_metric_integration.test", "metric_integration.id", "1"),
+					resource.TestCheckResourceAttr("statuspal_metric_integration.test", "metric_integration.type", "datadog"),
+					resource.TestCheckResourceAttr("statuspal_metric_integration.test", "metric_integration.name", "Datadog"),
+					resource.TestCheckNoResourceAttr("statuspal_metric_integration.test", "metric_integration.credentials.%"),
+					resource.TestCheckResourceAttr("statuspal_metric_integration.test", "metric_integration.credentials_version", "1"),
+					resource.TestCheckResourceAttr("statuspal_metric_integration.test", "metric_integration.inserted_at", "2024-05-16T10:00:00"),
+					func(_ *terraform.State) error {
+						if len(credentials) != 1 || credentials[0]["application_key"] != "application-key" {
+							return fmt.Errorf("expected the credentials to be sent to StatusPal, got: %v", credentials)
+						}
+						return nil
+					},
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "statuspal_metric_integration.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateId:           "example-com 1",
+				ImportStateVerifyIgnore: []string{"metric_integration.credentials_version"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "statuspal_metric_integration" "test" {
+  status_page_subdomain = "example-com"
+  metric_integration = {
+    type = "datadog"
+    name = "Datadog EU"
+    credentials = {
+      api_key         = "api-key"
+      application_key = "application-key"
+    }
+    credentials_version = 1
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("statuspal_metric_integration.test", "metric_integration.id", "1"),
+					resource.TestCheckResourceAttr("statuspal_metric_integration.test", "metric_integration.name", "Datadog EU"),
+					resource.TestCheckResourceAttr("statuspal_metric_integration.test", "metric_integration.updated_at", "2024-05-16T11:00:00"),
+					resource.TestCheckNoResourceAttr("statuspal_metric_integration.test", "metric_integration.credentials.%"),
+					func(_ *terraform.State) error {
+						if last := credentials[len(credentials)-1]; last != nil {
+							return fmt.Errorf("expected the unchanged credentials not to be sent, got: %v", last)
+						}
+						return nil
+					},
+				),
+			},
+			// Credentials rotation testing
+			{
+				Config: providerConfig + `
+resource "statuspal_metric_integration" "test" {
+  status_page_subdomain = "example-com"
+  metric_integration = {
+    type = "datadog"
+    name = "Datadog EU"
+    credentials = {
+      api_key         = "new-api-key"
+      application_key = "new-application-key"
+    }
+    credentials_version = 2
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("statuspal_metric_integration.test", "metric_integration.credentials.%"),
+					resource.TestCheckResourceAttr("statuspal_metric_integration.test", "metric_integration.credentials_version", "2"),
+					func(_ *terraform.State) error {
+						if last := credentials[len(credentials)-1]; last["api_key"] != "new-api-key" {
+							return fmt.Errorf("expected the rotated credentials to be sent to StatusPal, got: %v", last)
+						}
+						return nil
+					},
+				),
+			},
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			if deleted.Load() != 1 {
+				return fmt.Errorf("expected the metric integration to be deleted")
+			}
+			return nil
+		},
+	})
+}
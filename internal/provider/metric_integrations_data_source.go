@@ -0,0 +1,187 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	statuspal "terraform-provider-statuspal/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &metricIntegrationsDataSource{}
+	_ datasource.DataSourceWithConfigure = &metricIntegrationsDataSource{}
+)
+
+// NewMetricIntegrationsDataSource is a helper function to simplify the provider implementation.
+func NewMetricIntegrationsDataSource() datasource.DataSource {
+	return &metricIntegrationsDataSource{}
+}
+
+// metricIntegrationsDataSource is the data source implementation.
+type metricIntegrationsDataSource struct {
+	client *statuspal.Client
+}
+
+// metricIntegrationsDataSourceModel maps the data source schema data.
+type metricIntegrationsDataSourceModel struct {
+	ID                  types.String                            `tfsdk:"id"` // only for test case
+	StatusPageSubdomain types.String                            `tfsdk:"status_page_subdomain"`
+	Limit               types.Int64                             `tfsdk:"limit"`
+	MetricIntegrations  []metricIntegrationsDataSourceItemModel `tfsdk:"metric_integrations"`
+}
+
+// metricIntegrationsDataSourceItemModel maps a metric integration of the data
+// source, without its write-only credentials.
+type metricIntegrationsDataSourceItemModel struct {
+	ID         types.String `tfsdk:"id"`
+	Type       types.String `tfsdk:"type"`
+	Name       types.String `tfsdk:"name"`
+	InsertedAt types.String `tfsdk:"inserted_at"`
+	UpdatedAt  types.String `tfsdk:"updated_at"`
+}
+
+// Metadata returns the data source type name.
+func (d *metricIntegrationsDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_metric_integrations"
+}
+
+// Schema defines the schema for the data source.
+func (d *metricIntegrationsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the list of the monitoring services the metrics of the status page pull their data from.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier attribute. Ignore it, only used in testing.",
+				Computed:    true,
+			},
+			"status_page_subdomain": schema.StringAttribute{
+				Description: "The status page subdomain of the metric integrations.",
+				Required:    true,
+			},
+			"limit": schema.Int64Attribute{
+				Description: "The maximum number of metric integrations to return. By default, all the metric integrations of the status page are returned.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"metric_integrations": schema.ListNestedAttribute{
+				Description: "List of metric integrations.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the metric integration.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "The monitoring service of the integration.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the metric integration.",
+							Computed:    true,
+						},
+						"inserted_at": schema.StringAttribute{
+							Description: "Datetime at which the metric integration was inserted.",
+							Computed:    true,
+						},
+						"updated_at": schema.StringAttribute{
+							Description: "Datetime at which the metric integration was last updated.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *metricIntegrationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Retrieve values from config
+	var state metricIntegrationsDataSourceModel
+	diagnostics := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diagnostics...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	limit := state.Limit.ValueInt64()
+	integrations := []statuspal.MetricIntegration{}
+	err := d.client.ListMetricIntegrations(ctx, state.StatusPageSubdomain.ValueString(), func(page []statuspal.MetricIntegration) bool {
+		integrations = append(integrations, page...)
+		return limit == 0 || int64(len(integrations)) < limit
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read StatusPal Metric Integrations",
+			err.Error(),
+		)
+		return
+	}
+
+	if limit > 0 && int64(len(integrations)) > limit {
+		integrations = integrations[:limit]
+	}
+
+	// Map response body to model
+	state.MetricIntegrations = make([]metricIntegrationsDataSourceItemModel, 0, len(integrations))
+	for _, integration := range integrations {
+		state.MetricIntegrations = append(state.MetricIntegrations, metricIntegrationsDataSourceItemModel{
+			ID:         types.StringValue(strconv.FormatInt(integration.ID, 10)),
+			Type:       types.StringValue(integration.Type),
+			Name:       types.StringValue(integration.Name),
+			InsertedAt: types.StringValue(integration.InsertedAt),
+			UpdatedAt:  types.StringValue(integration.UpdatedAt),
+		})
+	}
+	state.ID = types.StringValue("placeholder") // only for test case
+
+	// Set state
+	diagnostics = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diagnostics...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *metricIntegrationsDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*statuspal.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf(
+				"Expected *statuspal.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	d.client = client
+}
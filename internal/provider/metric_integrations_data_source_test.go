@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccMetricIntegrationsDataSource(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /status_pages/example-com/metric_integrations", func(w http.ResponseWriter, r *http.Request) {
+		if _, err := w.Write([]byte(`{
+			"metric_integrations": [
+				{
+					"id": 1,
+					"type": "datadog",
+					"name": "Datadog",
+					"inserted_at": "2024-05-16T10:00:00",
+					"updated_at": "2024-05-16T10:00:00"
+				},
+				{
+					"id": 2,
+					"type": "pingdom",
+					"name": "Pingdom",
+					"inserted_at": "2024-05-16T10:00:00",
+					"updated_at": "2024-05-16T10:00:00"
+				}
+			],
+			"links": {"prev": null, "next": null}
+		}`)); err != nil {
+			log.Printf(`Error writing "/status_pages/example-com/metric_integrations" response: %v`, err)
+		}
+	})
+	mock := httptest.NewServer(mux)
+	defer mock.Close()
+
+	providerConfig := *providerConfig(&mock.URL)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `
+data "statuspal_metric_integrations" "test" {
+  status_page_subdomain = "example-com"
+}
+
+data "statuspal_metric_integrations" "limited" {
+  status_page_subdomain = "example-com"
+  limit                 = 1
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.statuspal_metric_integrations.test", "metric_integrations.#", "2"),
+					resource.TestCheckResourceAttr("data.statuspal_metric_integrations.test", "metric_integrations.0.id", "1"),
+					resource.TestCheckResourceAttr("data.statuspal_metric_integrations.test", "metric_integrations.0.type", "datadog"),
+					resource.TestCheckResourceAttr("data.statuspal_metric_integrations.test", "metric_integrations.1.name", "Pingdom"),
+					resource.TestCheckNoResourceAttr("data.statuspal_metric_integrations.test", "metric_integrations.0.credentials"),
+					resource.TestCheckResourceAttr("data.statuspal_metric_integrations.limited", "metric_integrations.#", "1"),
+				),
+			},
+		},
+	})
+}
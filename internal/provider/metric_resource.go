@@ -162,9 +162,9 @@ func (r *MetricResource) Schema(ctx context.Context, req resource.SchemaRequest,
 						},
 					},
 					"integration_id": schema.Int64Attribute{
-						Description: "The integration ID related to the metric.",
-						Optional:    true,
-						Computed:    true,
+						MarkdownDescription: "The integration ID related to the metric, e.g. `tonumber(statuspal_metric_integration.example.metric_integration.id)`.",
+						Optional:            true,
+						Computed:            true,
 					},
 				},
 			},
@@ -207,9 +207,9 @@ func (r *MetricResource) Create(ctx context.Context, req resource.CreateRequest,
 	var model statuspal.Metric
 	mapResourceModelToMetric(&model, &data)
 
-	metric, err := r.client.CreateMetric(data.StatusPageSubdomain.ValueString(), &model)
+	metric, err := r.client.CreateMetric(ctx, data.StatusPageSubdomain.ValueString(), &model)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create the metric, got error: %s", err))
+		addClientError(&resp.Diagnostics, path.Root("metric"), "Client Error", "Unable to create the metric, got error: ", err)
 
 		return
 	}
@@ -230,7 +230,7 @@ func (r *MetricResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
-	metric, err := r.client.GetMetric(data.Metric.ID.ValueString(), data.StatusPageSubdomain.ValueString())
+	metric, err := r.client.GetMetric(ctx, data.Metric.ID.ValueString(), data.StatusPageSubdomain.ValueString())
 	if statuspal.ErrorNotFound(err) {
 		resp.State.RemoveResource(ctx)
 
@@ -269,9 +269,9 @@ func (r *MetricResource) Update(ctx context.Context, req resource.UpdateRequest,
 	var model statuspal.Metric
 	mapResourceModelToMetric(&model, &data)
 
-	metric, err := r.client.UpdateMetric(id, subdomain, &model)
+	metric, err := r.client.UpdateMetric(ctx, id, subdomain, &model)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update the metric, got error: %s", err))
+		addClientError(&resp.Diagnostics, path.Root("metric"), "Client Error", "Unable to update the metric, got error: ", err)
 
 		return
 	}
@@ -291,7 +291,7 @@ func (r *MetricResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	if err := r.client.DeleteMetric(data.Metric.ID.ValueString(), data.StatusPageSubdomain.ValueString()); err != nil && !statuspal.ErrorNotFound(err) {
+	if err := r.client.DeleteMetric(ctx, data.Metric.ID.ValueString(), data.StatusPageSubdomain.ValueString()); err != nil && !statuspal.ErrorNotFound(err) {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete the metric, got error: %s", err))
 
 		return
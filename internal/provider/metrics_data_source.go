@@ -8,8 +8,10 @@ import (
 	statuspal "terraform-provider-statuspal/internal/client"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
@@ -30,10 +32,18 @@ type queryMetrics struct {
 	Limit  *int64  `tfsdk:"limit"`
 }
 
+type filterMetrics struct {
+	Type       types.String `tfsdk:"type"`
+	Enabled    types.Bool   `tfsdk:"enabled"`
+	TitleRegex types.String `tfsdk:"title_regex"`
+}
+
 type MetricsDataSourceModel struct {
 	ID                  types.String  `tfsdk:"id"` // only for test case
 	StatusPageSubdomain types.String  `tfsdk:"status_page_subdomain"`
+	Limit               types.Int64   `tfsdk:"limit"`
 	Query               types.Object  `tfsdk:"query"`
+	Filter              types.Object  `tfsdk:"filter"`
 	Metrics             []metricModel `tfsdk:"metrics"`
 }
 
@@ -56,7 +66,7 @@ func (d *MetricsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 						Optional:    true,
 					},
 					"limit": schema.Int64Attribute{
-						Description: "Set the number of metrics to return in the response. This defaults to 20 items",
+						Description: "Set the number of metrics requested per page, all the pages are followed. This defaults to 20 items",
 						Optional:    true,
 						Validators: []validator.Int64{
 							int64validator.AtLeast(1),
@@ -65,6 +75,26 @@ func (d *MetricsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 					},
 				},
 			},
+			"filter": schema.SingleNestedBlock{
+				Description: "Only return the metrics matching all the given conditions.",
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						MarkdownDescription: "Only return the metrics of this type. Enum: " + quotedValuesDescription([]string{UptimeMetric, ResponseTimeMetric}) + ".",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(UptimeMetric, ResponseTimeMetric),
+						},
+					},
+					"enabled": schema.BoolAttribute{
+						Description: "Only return the enabled (true) or disabled (false) metrics.",
+						Optional:    true,
+					},
+					"title_regex": schema.StringAttribute{
+						Description: "Only return the metrics with a title matching this regular expression.",
+						Optional:    true,
+					},
+				},
+			},
 		},
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -75,6 +105,13 @@ func (d *MetricsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 				Description: "The status page subdomain of the services.",
 				Required:    true,
 			},
+			"limit": schema.Int64Attribute{
+				Description: "The maximum number of metrics to return, after filtering. By default, all the matching metrics of the status page are returned.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
 			"metrics": schema.ListNestedAttribute{
 				Description: "The metrics",
 				Computed:    true,
@@ -154,24 +191,59 @@ func (d *MetricsDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	var query statuspal.MetricsQuery
 	if !data.Query.IsNull() {
 		var q queryMetrics
-		resp.Diagnostics.Append(data.Query.As(ctx, &query, basetypes.ObjectAsOptions{})...)
+		resp.Diagnostics.Append(data.Query.As(ctx, &q, basetypes.ObjectAsOptions{})...)
 		if resp.Diagnostics.HasError() {
 			return
 		}
 
-		query.After = *q.After
-		query.Before = *q.Before
-		query.Limit = *q.Limit
+		if q.After != nil {
+			query.After = *q.After
+		}
+		if q.Before != nil {
+			query.Before = *q.Before
+		}
+		if q.Limit != nil {
+			query.Limit = *q.Limit
+		}
 	}
 
-	metric, err := d.client.GetMetrics(data.StatusPageSubdomain.ValueString(), query)
+	// The type and enabled filters are sent as query parameters of the StatusPal
+	// API, the title regex is matched on each page as they are received.
+	var filter filterMetrics
+	if !data.Filter.IsNull() {
+		resp.Diagnostics.Append(data.Filter.As(ctx, &filter, basetypes.ObjectAsOptions{})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	query.Type = filter.Type.ValueString()
+	query.Enabled = filter.Enabled.ValueBoolPointer()
+	titleRegex := compileRegex(filter.TitleRegex, path.Root("filter").AtName("title_regex"), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	limit := data.Limit.ValueInt64()
+	metrics := []statuspal.Metric{}
+	err := d.client.ListMetrics(ctx, data.StatusPageSubdomain.ValueString(), query, func(page []statuspal.Metric) bool {
+		for _, metric := range page {
+			if titleRegex == nil || titleRegex.MatchString(metric.Title) {
+				metrics = append(metrics, metric)
+			}
+		}
+		return limit == 0 || int64(len(metrics)) < limit
+	})
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get the metric, got error: %s", err))
 
 		return
 	}
 
-	mapMetricsToDataSourceModel(metric, &data)
+	if limit > 0 && int64(len(metrics)) > limit {
+		metrics = metrics[:limit]
+	}
+
+	mapMetricsToDataSourceModel(&metrics, &data)
 	data.ID = types.StringValue("placeholder") // only for test case
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -2,13 +2,18 @@ package provider
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"regexp"
+	"strconv"
+	"sync"
 	"testing"
 
 	statuspal "terraform-provider-statuspal/internal/client"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 func TestAccMetricsDataSource(t *testing.T) {
@@ -138,3 +143,93 @@ data "statuspal_metrics" "test_empty" {
 		},
 	})
 }
+
+func TestAccMetricsDataSource_queryAndFilter(t *testing.T) {
+	var mutex sync.Mutex
+	var queries []string
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/status_pages/example-com/metrics", func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		queries = append(queries, r.URL.RawQuery)
+		mutex.Unlock()
+
+		// The API filters the metrics with the type and enabled query parameters
+		metrics := []statuspal.Metric{}
+		for _, metric := range []statuspal.Metric{
+			{ID: 1, Title: "Website Uptime", Type: "up", Enabled: true},
+			{ID: 2, Title: "Website Response Time", Type: "rt", Enabled: true},
+			{ID: 3, Title: "API Response Time", Type: "rt", Enabled: false},
+		} {
+			query := r.URL.Query()
+			if query.Has("type") && metric.Type != query.Get("type") {
+				continue
+			}
+			if query.Has("enabled") && strconv.FormatBool(metric.Enabled) != query.Get("enabled") {
+				continue
+			}
+			metrics = append(metrics, metric)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(statuspal.MetricsBody{Metrics: metrics}) //nolint:errcheck
+	})
+
+	mock := httptest.NewServer(mux)
+	defer mock.Close()
+	providerConfig := *providerConfig(&mock.URL)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "statuspal_metrics" "test" {
+  status_page_subdomain = "example-com"
+
+  filter {
+    type = "gauge"
+  }
+}`,
+				ExpectError: regexp.MustCompile(`Attribute filter.type value must be one of`),
+			},
+			{
+				Config: providerConfig + `
+data "statuspal_metrics" "response_times" {
+  status_page_subdomain = "example-com"
+
+  query {
+    limit = 50
+  }
+
+  filter {
+    type    = "rt"
+    enabled = true
+  }
+}
+
+data "statuspal_metrics" "website" {
+  status_page_subdomain = "example-com"
+
+  filter {
+    title_regex = "^Website"
+  }
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.statuspal_metrics.response_times", "metrics.#", "1"),
+					resource.TestCheckResourceAttr("data.statuspal_metrics.response_times", "metrics.0.id", "2"),
+					resource.TestCheckResourceAttr("data.statuspal_metrics.website", "metrics.#", "2"),
+					resource.TestCheckResourceAttr("data.statuspal_metrics.website", "metrics.1.title", "Website Response Time"),
+					func(_ *terraform.State) error {
+						for _, query := range queries {
+							if query == "enabled=true&limit=50&type=rt" {
+								return nil
+							}
+						}
+						return fmt.Errorf("expected the query limit and filters to be sent to StatusPal, got: %v", queries)
+					},
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,447 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	statuspal "terraform-provider-statuspal/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &noticeResource{}
+	_ resource.ResourceWithConfigure      = &noticeResource{}
+	_ resource.ResourceWithImportState    = &noticeResource{}
+	_ resource.ResourceWithValidateConfig = &noticeResource{}
+)
+
+// NewNoticeResource is a helper function to simplify the provider implementation.
+func NewNoticeResource() resource.Resource {
+	return &noticeResource{}
+}
+
+// noticeResource is the resource implementation.
+type noticeResource struct {
+	client *statuspal.Client
+}
+
+// noticeResourceModel maps the resource schema data.
+type noticeResourceModel struct {
+	ID                  types.String `tfsdk:"id"` // only for test case
+	StatusPageSubdomain types.String `tfsdk:"status_page_subdomain"`
+	Notice              noticeModel  `tfsdk:"notice"`
+}
+
+// noticeModel maps notice schema data.
+type noticeModel struct {
+	ID           types.String `tfsdk:"id"`
+	Message      types.String `tfsdk:"message"`
+	Translations types.Map    `tfsdk:"translations"`
+	StartsAt     types.String `tfsdk:"starts_at"`
+	EndsAt       types.String `tfsdk:"ends_at"`
+	Visible      types.Bool   `tfsdk:"visible"`
+	InsertedAt   types.String `tfsdk:"inserted_at"`
+	UpdatedAt    types.String `tfsdk:"updated_at"`
+}
+
+type noticeTranslationsModel map[string]noticeTranslationModel
+
+type noticeTranslationModel struct {
+	Message types.String `tfsdk:"message"`
+}
+
+// noticeTranslationAttrTypes is the type of the notice translations.
+var noticeTranslationAttrTypes = map[string]attr.Type{
+	"message": types.StringType,
+}
+
+// Metadata returns the resource type name.
+func (r *noticeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_notice"
+}
+
+// Schema defines the schema for the resource.
+func (r *noticeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages an informational notice displayed on the status page. The `info_notices_enabled` attribute of the status page must be enabled.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier attribute. Ignore it, only used in testing.",
+				Computed:    true,
+			},
+			"status_page_subdomain": schema.StringAttribute{
+				Description: "The status page's subdomain where the notice belong.",
+				Required:    true,
+			},
+			"notice": schema.SingleNestedAttribute{
+				Description: "The notice.",
+				Required:    true,
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Description: "The ID of the notice.",
+						Computed:    true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"message": schema.StringAttribute{
+						Description: "The message of the notice.",
+						Required:    true,
+					},
+					"translations": schema.MapNestedAttribute{
+						MarkdownDescription: "A translations object. For example:\n  ```terraform" + `
+	{
+		fr = {
+			message = "Le support est réduit pendant les fêtes."
+		}
+	}
+` + "  ```\n→ ",
+						Optional: true,
+						Computed: true,
+						Default:  mapdefault.StaticValue(types.MapNull(types.ObjectType{AttrTypes: noticeTranslationAttrTypes})),
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"message": schema.StringAttribute{
+									Description: "The message of the notice.",
+									Required:    true,
+								},
+							},
+						},
+					},
+					"starts_at": schema.StringAttribute{
+						MarkdownDescription: "Datetime from which the notice is displayed, e.g. `2024-12-23T00:00:00Z`. By default, the notice is displayed immediately.",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(dateTimeRegexp, "must be an ISO 8601 date time, e.g. 2024-12-23T00:00:00Z"),
+						},
+					},
+					"ends_at": schema.StringAttribute{
+						MarkdownDescription: "Datetime at which the notice expires, e.g. `2025-01-02T00:00:00Z`. It must be after `starts_at`. By default, the notice never expires.",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(dateTimeRegexp, "must be an ISO 8601 date time, e.g. 2025-01-02T00:00:00Z"),
+						},
+					},
+					"visible": schema.BoolAttribute{
+						MarkdownDescription: "Display the notice on the status page? Set it to `false` to hide the notice without deleting it. Defaults to `true`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(true),
+					},
+					"inserted_at": schema.StringAttribute{
+						Description: "Datetime at which the notice was inserted.",
+						Computed:    true,
+					},
+					"updated_at": schema.StringAttribute{
+						Description: "Datetime at which the notice was last updated.",
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that the notice expires after it starts.
+func (r *noticeResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	validateEndsAfterStarts(ctx, req.Config, path.Root("notice"),
+		"Invalid StatusPal Notice End Time",
+		"The notice must expire after it starts",
+		&resp.Diagnostics,
+	)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *noticeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan noticeResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	notice := mapNoticeModelToRequestBody(ctx, &plan.Notice, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create new notice
+	statusPageSubdomain := plan.StatusPageSubdomain.ValueString()
+	newNotice, err := r.client.CreateNotice(ctx, notice, &statusPageSubdomain)
+	if err != nil {
+		addClientError(&resp.Diagnostics, path.Root("notice"),
+			"Error creating StatusPal Notice",
+			"Could not create notice, unexpected error: ",
+			err,
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	noticeModel := mapResponseToNoticeModel(newNotice, &plan.Notice, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.Notice = *noticeModel
+	plan.ID = types.StringValue("placeholder") // only for test case
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *noticeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state noticeResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed notice value from StatusPal
+	statusPageSubdomain := state.StatusPageSubdomain.ValueString()
+	noticeID := state.Notice.ID.ValueString()
+	notice, err := r.client.GetNotice(ctx, &statusPageSubdomain, &noticeID)
+	if statuspal.ErrorNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading StatusPal Notice",
+			"Could not read notice ID "+noticeID+": "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	noticeModel := mapResponseToNoticeModel(notice, &state.Notice, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Notice = *noticeModel
+	state.ID = types.StringValue("placeholder") // only for test case
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *noticeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan noticeResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	notice := mapNoticeModelToRequestBody(ctx, &plan.Notice, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update existing notice
+	statusPageSubdomain := plan.StatusPageSubdomain.ValueString()
+	noticeID := plan.Notice.ID.ValueString()
+	updatedNotice, err := r.client.UpdateNotice(ctx, notice, &statusPageSubdomain, &noticeID)
+	if err != nil {
+		addClientError(&resp.Diagnostics, path.Root("notice"),
+			"Error Updating StatusPal Notice",
+			"Could not Update notice, unexpected error: ",
+			err,
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	noticeModel := mapResponseToNoticeModel(updatedNotice, &plan.Notice, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.Notice = *noticeModel
+	plan.ID = types.StringValue("placeholder") // only for test case
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *noticeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state noticeResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing notice
+	statusPageSubdomain := state.StatusPageSubdomain.ValueString()
+	noticeID := state.Notice.ID.ValueString()
+	err := r.client.DeleteNotice(ctx, &statusPageSubdomain, &noticeID)
+	if err != nil && !statuspal.ErrorNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting StatusPal Notice",
+			"Could not delete notice, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *noticeResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	// Split the ID based on the delimiter used during import
+	parts := strings.Split(req.ID, " ")
+	if len(parts) != 2 {
+		resp.Diagnostics.AddError(
+			"Unexpected StatusPal Notice Import Identifier",
+			`Expected StatusPal notice import identifier with format: "<status_page_subdomain> <notice_id>"`,
+		)
+		return
+	}
+
+	req.ID = parts[0]
+	resource.ImportStatePassthroughID(ctx, path.Root("status_page_subdomain"), req, resp)
+	req.ID = parts[1]
+	resource.ImportStatePassthroughID(ctx, path.Root("notice").AtName("id"), req, resp)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *noticeResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*statuspal.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf(
+				"Expected *statuspal.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func mapNoticeModelToRequestBody(
+	ctx context.Context,
+	notice *noticeModel,
+	diagnostics *diag.Diagnostics,
+) *statuspal.Notice {
+	translationData := make(statuspal.NoticeTranslations)
+	if !notice.Translations.IsNull() && !notice.Translations.IsUnknown() {
+		translations := make(noticeTranslationsModel, len(notice.Translations.Elements()))
+		diags := notice.Translations.ElementsAs(ctx, &translations, false)
+		diagnostics.Append(diags...)
+		if diagnostics.HasError() {
+			return nil
+		}
+
+		for lang, data := range translations {
+			translationData[lang] = statuspal.NoticeTranslation{
+				Message: data.Message.ValueString(),
+			}
+		}
+	}
+
+	return &statuspal.Notice{
+		Message:      notice.Message.ValueString(),
+		Translations: translationData,
+		StartsAt:     notice.StartsAt.ValueStringPointer(),
+		EndsAt:       notice.EndsAt.ValueStringPointer(),
+		Visible:      notice.Visible.ValueBool(),
+	}
+}
+
+func mapResponseToNoticeModel(
+	notice *statuspal.Notice,
+	previous *noticeModel,
+	diagnostics *diag.Diagnostics,
+) *noticeModel {
+	translations := types.MapNull(types.ObjectType{AttrTypes: noticeTranslationAttrTypes})
+	if len(notice.Translations) > 0 {
+		translationData := make(map[string]attr.Value)
+		for lang, data := range notice.Translations {
+			translationObject, diags := types.ObjectValue(
+				noticeTranslationAttrTypes,
+				map[string]attr.Value{
+					"message": types.StringValue(data.Message),
+				},
+			)
+			translationData[lang] = translationObject
+			diagnostics.Append(diags...)
+			if diagnostics.HasError() {
+				return nil
+			}
+		}
+
+		convertedTranslations, diags := types.MapValue(
+			types.ObjectType{AttrTypes: noticeTranslationAttrTypes},
+			translationData,
+		)
+		diagnostics.Append(diags...)
+		if diagnostics.HasError() {
+			return nil
+		}
+
+		translations = convertedTranslations
+	}
+
+	return &noticeModel{
+		ID:           types.StringValue(strconv.FormatInt(notice.ID, 10)),
+		Message:      types.StringValue(notice.Message),
+		Translations: translations,
+		StartsAt:     optionalDateTimeValue(previous.StartsAt, notice.StartsAt),
+		EndsAt:       optionalDateTimeValue(previous.EndsAt, notice.EndsAt),
+		Visible:      types.BoolValue(notice.Visible),
+		InsertedAt:   types.StringValue(notice.InsertedAt),
+		UpdatedAt:    types.StringValue(notice.UpdatedAt),
+	}
+}
@@ -0,0 +1,154 @@
+package provider
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccNoticeResource(t *testing.T) {
+	responseBody := `{
+		"notice": {
+			"id": 1,
+			"message": "Reduced support during the holidays.",
+			"translations": {},
+			"starts_at": null,
+			"ends_at": null,
+			"visible": true,
+			"inserted_at": "2024-05-16T10:00:00",
+			"updated_at": "2024-05-16T10:00:00"
+		}
+	}`
+	updatedResponseBody := `{
+		"notice": {
+			"id": 1,
+			"message": "Reduced support during the holidays.",
+			"translations": {"fr": {"message": "Le support est réduit pendant les fêtes."}},
+			"starts_at": "2024-12-23T00:00:00",
+			"ends_at": "2025-01-02T00:00:00",
+			"visible": false,
+			"inserted_at": "2024-05-16T10:00:00",
+			"updated_at": "2024-05-16T11:00:00"
+		}
+	}`
+
+	var updated atomic.Bool
+	var deleted atomic.Int32
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /status_pages/example-com/notices", func(w http.ResponseWriter, r *http.Request) {
+		if _, err := w.Write([]byte(responseBody)); err != nil {
+			log.Printf(`Error writing "/status_pages/example-com/notices" response: %v`, err)
+		}
+	})
+	mux.HandleFunc("/status_pages/example-com/notices/1", func(w http.ResponseWriter, r *http.Request) {
+		body := responseBody
+		if updated.Load() {
+			body = updatedResponseBody
+		}
+
+		switch r.Method {
+		case http.MethodPut:
+			updated.Store(true)
+			body = updatedResponseBody
+		case http.MethodDelete:
+			deleted.Add(1)
+			body = `""`
+		}
+
+		if _, err := w.Write([]byte(body)); err != nil {
+			log.Printf(`Error writing "/status_pages/example-com/notices/1" response with method "%s": %v`, r.Method, err)
+		}
+	})
+	mock := httptest.NewServer(mux)
+	defer mock.Close()
+
+	providerConfig := *providerConfig(&mock.URL)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Invalid end time error testing
+			{
+				Config: providerConfig + `
+resource "statuspal_notice" "test" {
+  status_page_subdomain = "example-com"
+  notice = {
+    message   = "Reduced support during the holidays."
+    starts_at = "2024-12-23T00:00:00Z"
+    ends_at   = "2024-12-22T00:00:00Z"
+  }
+}
+`,
+				ExpectError: regexp.MustCompile(`The notice must expire after it starts`),
+			},
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "statuspal_notice" "test" {
+  status_page_subdomain = "example-com"
+  notice = {
+    message = "Reduced support during the holidays."
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("statuspal_notice.test", "status_page_subdomain", "example-com"),
+					resource.TestCheckResourceAttr("statuspal_notice.test", "notice.id", "1"),
+					resource.TestCheckResourceAttr("statuspal_notice.test", "notice.message", "Reduced support during the holidays."),
+					resource.TestCheckResourceAttr("statuspal_notice.test", "notice.visible", "true"),
+					resource.TestCheckNoResourceAttr("statuspal_notice.test", "notice.starts_at"),
+					resource.TestCheckNoResourceAttr("statuspal_notice.test", "notice.ends_at"),
+					resource.TestCheckNoResourceAttr("statuspal_notice.test", "notice.translations"),
+					resource.TestCheckResourceAttr("statuspal_notice.test", "notice.inserted_at", "2024-05-16T10:00:00"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "statuspal_notice.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     "example-com 1",
+			},
+			// Update and Read testing, the date times are written with a time zone
+			{
+				Config: providerConfig + `
+resource "statuspal_notice" "test" {
+  status_page_subdomain = "example-com"
+  notice = {
+    message   = "Reduced support during the holidays."
+    starts_at = "2024-12-23T00:00:00Z"
+    ends_at   = "2025-01-02T00:00:00Z"
+    visible   = false
+    translations = {
+      fr = {
+        message = "Le support est réduit pendant les fêtes."
+      }
+    }
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("statuspal_notice.test", "notice.id", "1"),
+					resource.TestCheckResourceAttr("statuspal_notice.test", "notice.starts_at", "2024-12-23T00:00:00Z"),
+					resource.TestCheckResourceAttr("statuspal_notice.test", "notice.ends_at", "2025-01-02T00:00:00Z"),
+					resource.TestCheckResourceAttr("statuspal_notice.test", "notice.visible", "false"),
+					resource.TestCheckResourceAttr("statuspal_notice.test", "notice.translations.fr.message", "Le support est réduit pendant les fêtes."),
+					resource.TestCheckResourceAttr("statuspal_notice.test", "notice.updated_at", "2024-05-16T11:00:00"),
+				),
+			},
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			if deleted.Load() != 1 {
+				return fmt.Errorf("expected the notice to be deleted")
+			}
+			return nil
+		},
+	})
+}
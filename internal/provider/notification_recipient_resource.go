@@ -0,0 +1,327 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	statuspal "terraform-provider-statuspal/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &notificationRecipientResource{}
+	_ resource.ResourceWithConfigure      = &notificationRecipientResource{}
+	_ resource.ResourceWithImportState    = &notificationRecipientResource{}
+	_ resource.ResourceWithValidateConfig = &notificationRecipientResource{}
+)
+
+// NewNotificationRecipientResource is a helper function to simplify the provider implementation.
+func NewNotificationRecipientResource() resource.Resource {
+	return &notificationRecipientResource{}
+}
+
+// notificationRecipientResource is the resource implementation.
+type notificationRecipientResource struct {
+	client *statuspal.Client
+}
+
+// notificationRecipientResourceModel maps the resource schema data.
+type notificationRecipientResourceModel struct {
+	ID                    types.String               `tfsdk:"id"` // only for test case
+	StatusPageSubdomain   types.String               `tfsdk:"status_page_subdomain"`
+	NotificationRecipient notificationRecipientModel `tfsdk:"notification_recipient"`
+}
+
+// notificationRecipientModel maps notification recipient schema data.
+type notificationRecipientModel struct {
+	ID         types.String `tfsdk:"id"`
+	Email      types.String `tfsdk:"email"`
+	Name       types.String `tfsdk:"name"`
+	InsertedAt types.String `tfsdk:"inserted_at"`
+	UpdatedAt  types.String `tfsdk:"updated_at"`
+}
+
+// Metadata returns the resource type name.
+func (r *notificationRecipientResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_notification_recipient"
+}
+
+// Schema defines the schema for the resource.
+func (r *notificationRecipientResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an internal recipient of the monitoring alerts of the status page.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier attribute. Ignore it, only used in testing.",
+				Computed:    true,
+			},
+			"status_page_subdomain": schema.StringAttribute{
+				Description: "The status page's subdomain where the notification recipient belong.",
+				Required:    true,
+			},
+			"notification_recipient": schema.SingleNestedAttribute{
+				Description: "The notification recipient.",
+				Required:    true,
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Description: "The ID of the notification recipient.",
+						Computed:    true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"email": schema.StringAttribute{
+						Description: "The email address the monitoring alerts are sent to.",
+						Required:    true,
+					},
+					"name": schema.StringAttribute{
+						Description: "The name of the notification recipient.",
+						Optional:    true,
+					},
+					"inserted_at": schema.StringAttribute{
+						Description: "Datetime at which the notification recipient was inserted.",
+						Computed:    true,
+					},
+					"updated_at": schema.StringAttribute{
+						Description: "Datetime at which the notification recipient was last updated.",
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig checks the email address of the notification recipient.
+func (r *notificationRecipientResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var email types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("notification_recipient").AtName("email"), &email)...)
+	if resp.Diagnostics.HasError() || email.IsNull() || email.IsUnknown() {
+		return
+	}
+
+	if detail := subscriberAddressError("email", email.ValueString()); detail != "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("notification_recipient").AtName("email"),
+			"Invalid StatusPal Notification Recipient Email",
+			detail,
+		)
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *notificationRecipientResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan notificationRecipientResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	recipient := mapNotificationRecipientModelToRequestBody(&plan.NotificationRecipient)
+
+	// Create new notification recipient
+	statusPageSubdomain := plan.StatusPageSubdomain.ValueString()
+	newRecipient, err := r.client.CreateNotificationRecipient(ctx, recipient, &statusPageSubdomain)
+	if err != nil {
+		addClientError(&resp.Diagnostics, path.Root("notification_recipient"),
+			"Error creating StatusPal Notification Recipient",
+			"Could not create notification recipient, unexpected error: ",
+			err,
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.NotificationRecipient = *mapResponseToNotificationRecipientModel(newRecipient)
+	plan.ID = types.StringValue("placeholder") // only for test case
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *notificationRecipientResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state notificationRecipientResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed notification recipient value from StatusPal
+	statusPageSubdomain := state.StatusPageSubdomain.ValueString()
+	recipientID := state.NotificationRecipient.ID.ValueString()
+	recipient, err := r.client.GetNotificationRecipient(ctx, &statusPageSubdomain, &recipientID)
+	if statuspal.ErrorNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading StatusPal Notification Recipient",
+			"Could not read notification recipient ID "+recipientID+": "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	state.NotificationRecipient = *mapResponseToNotificationRecipientModel(recipient)
+	state.ID = types.StringValue("placeholder") // only for test case
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *notificationRecipientResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan notificationRecipientResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	recipient := mapNotificationRecipientModelToRequestBody(&plan.NotificationRecipient)
+
+	// Update existing notification recipient
+	statusPageSubdomain := plan.StatusPageSubdomain.ValueString()
+	recipientID := plan.NotificationRecipient.ID.ValueString()
+	updatedRecipient, err := r.client.UpdateNotificationRecipient(ctx, recipient, &statusPageSubdomain, &recipientID)
+	if err != nil {
+		addClientError(&resp.Diagnostics, path.Root("notification_recipient"),
+			"Error Updating StatusPal Notification Recipient",
+			"Could not Update notification recipient, unexpected error: ",
+			err,
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.NotificationRecipient = *mapResponseToNotificationRecipientModel(updatedRecipient)
+	plan.ID = types.StringValue("placeholder") // only for test case
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *notificationRecipientResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state notificationRecipientResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing notification recipient
+	statusPageSubdomain := state.StatusPageSubdomain.ValueString()
+	recipientID := state.NotificationRecipient.ID.ValueString()
+	err := r.client.DeleteNotificationRecipient(ctx, &statusPageSubdomain, &recipientID)
+	if err != nil && !statuspal.ErrorNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting StatusPal Notification Recipient",
+			"Could not delete notification recipient, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *notificationRecipientResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	// Split the ID based on the delimiter used during import
+	parts := strings.Split(req.ID, " ")
+	if len(parts) != 2 {
+		resp.Diagnostics.AddError(
+			"Unexpected StatusPal Notification Recipient Import Identifier",
+			`Expected StatusPal notification recipient import identifier with format: "<status_page_subdomain> <notification_recipient_id>"`,
+		)
+		return
+	}
+
+	req.ID = parts[0]
+	resource.ImportStatePassthroughID(ctx, path.Root("status_page_subdomain"), req, resp)
+	req.ID = parts[1]
+	resource.ImportStatePassthroughID(ctx, path.Root("notification_recipient").AtName("id"), req, resp)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *notificationRecipientResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*statuspal.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf(
+				"Expected *statuspal.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func mapNotificationRecipientModelToRequestBody(recipient *notificationRecipientModel) *statuspal.NotificationRecipient {
+	return &statuspal.NotificationRecipient{
+		Email: recipient.Email.ValueString(),
+		Name:  recipient.Name.ValueString(),
+	}
+}
+
+func mapResponseToNotificationRecipientModel(recipient *statuspal.NotificationRecipient) *notificationRecipientModel {
+	name := types.StringNull()
+	if recipient.Name != "" {
+		name = types.StringValue(recipient.Name)
+	}
+
+	return &notificationRecipientModel{
+		ID:         types.StringValue(strconv.FormatInt(recipient.ID, 10)),
+		Email:      types.StringValue(recipient.Email),
+		Name:       name,
+		InsertedAt: types.StringValue(recipient.InsertedAt),
+		UpdatedAt:  types.StringValue(recipient.UpdatedAt),
+	}
+}
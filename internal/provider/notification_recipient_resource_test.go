@@ -0,0 +1,133 @@
+package provider
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccNotificationRecipientResource(t *testing.T) {
+	responseBody := `{
+		"notification_recipient": {
+			"id": 1,
+			"email": "oncall@example.com",
+			"inserted_at": "2024-05-16T10:00:00",
+			"updated_at": "2024-05-16T10:00:00"
+		}
+	}`
+	updatedResponseBody := `{
+		"notification_recipient": {
+			"id": 1,
+			"email": "sre@example.com",
+			"name": "SRE team",
+			"inserted_at": "2024-05-16T10:00:00",
+			"updated_at": "2024-05-16T11:00:00"
+		}
+	}`
+
+	var updated atomic.Bool
+	var deleted atomic.Int32
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /status_pages/example-com/notification_recipients", func(w http.ResponseWriter, r *http.Request) {
+		if _, err := w.Write([]byte(responseBody)); err != nil {
+			log.Printf(`Error writing "/status_pages/example-com/notification_recipients" response: %v`, err)
+		}
+	})
+	mux.HandleFunc("/status_pages/example-com/notification_recipients/1", func(w http.ResponseWriter, r *http.Request) {
+		body := responseBody
+		if updated.Load() {
+			body = updatedResponseBody
+		}
+
+		switch r.Method {
+		case http.MethodPut:
+			updated.Store(true)
+			body = updatedResponseBody
+		case http.MethodDelete:
+			deleted.Add(1)
+			body = `""`
+		}
+
+		if _, err := w.Write([]byte(body)); err != nil {
+			log.Printf(`Error writing "/status_pages/example-com/notification_recipients/1" response with method "%s": %v`, r.Method, err)
+		}
+	})
+	mock := httptest.NewServer(mux)
+	defer mock.Close()
+
+	providerConfig := *providerConfig(&mock.URL)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Invalid email error testing
+			{
+				Config: providerConfig + `
+resource "statuspal_notification_recipient" "test" {
+  status_page_subdomain = "example-com"
+  notification_recipient = {
+    email = "oncall"
+  }
+}
+`,
+				ExpectError: regexp.MustCompile(`must be an email address`),
+			},
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "statuspal_notification_recipient" "test" {
+  status_page_subdomain = "example-com"
+  notification_recipient = {
+    email = "oncall@example.com"
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("statuspal_notification_recipient.test", "status_page_subdomain", "example-com"),
+					resource.TestCheckResourceAttr("statuspal_notification_recipient.test", "notification_recipient.id", "1"),
+					resource.TestCheckResourceAttr("statuspal_notification_recipient.test", "notification_recipient.email", "oncall@example.com"),
+					resource.TestCheckNoResourceAttr("statuspal_notification_recipient.test", "notification_recipient.name"),
+					resource.TestCheckResourceAttr("statuspal_notification_recipient.test", "notification_recipient.inserted_at", "2024-05-16T10:00:00"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "statuspal_notification_recipient.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     "example-com 1",
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "statuspal_notification_recipient" "test" {
+  status_page_subdomain = "example-com"
+  notification_recipient = {
+    email = "sre@example.com"
+    name  = "SRE team"
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("statuspal_notification_recipient.test", "notification_recipient.id", "1"),
+					resource.TestCheckResourceAttr("statuspal_notification_recipient.test", "notification_recipient.email", "sre@example.com"),
+					resource.TestCheckResourceAttr("statuspal_notification_recipient.test", "notification_recipient.name", "SRE team"),
+					resource.TestCheckResourceAttr("statuspal_notification_recipient.test", "notification_recipient.updated_at", "2024-05-16T11:00:00"),
+				),
+			},
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			if deleted.Load() != 1 {
+				return fmt.Errorf("expected the notification recipient to be deleted")
+			}
+			return nil
+		},
+	})
+}
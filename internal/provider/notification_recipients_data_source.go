@@ -0,0 +1,171 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	statuspal "terraform-provider-statuspal/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &notificationRecipientsDataSource{}
+	_ datasource.DataSourceWithConfigure = &notificationRecipientsDataSource{}
+)
+
+// NewNotificationRecipientsDataSource is a helper function to simplify the provider implementation.
+func NewNotificationRecipientsDataSource() datasource.DataSource {
+	return &notificationRecipientsDataSource{}
+}
+
+// notificationRecipientsDataSource is the data source implementation.
+type notificationRecipientsDataSource struct {
+	client *statuspal.Client
+}
+
+// notificationRecipientsDataSourceModel maps the data source schema data.
+type notificationRecipientsDataSourceModel struct {
+	ID                     types.String                 `tfsdk:"id"` // only for test case
+	StatusPageSubdomain    types.String                 `tfsdk:"status_page_subdomain"`
+	Limit                  types.Int64                  `tfsdk:"limit"`
+	NotificationRecipients []notificationRecipientModel `tfsdk:"notification_recipients"`
+}
+
+// Metadata returns the data source type name.
+func (d *notificationRecipientsDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_notification_recipients"
+}
+
+// Schema defines the schema for the data source.
+func (d *notificationRecipientsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the list of internal recipients of the monitoring alerts of the status page.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier attribute. Ignore it, only used in testing.",
+				Computed:    true,
+			},
+			"status_page_subdomain": schema.StringAttribute{
+				Description: "The status page subdomain of the notification recipients.",
+				Required:    true,
+			},
+			"limit": schema.Int64Attribute{
+				Description: "The maximum number of notification recipients to return. By default, all the notification recipients of the status page are returned.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"notification_recipients": schema.ListNestedAttribute{
+				Description: "List of notification recipients.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the notification recipient.",
+							Computed:    true,
+						},
+						"email": schema.StringAttribute{
+							Description: "The email address the monitoring alerts are sent to.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the notification recipient.",
+							Computed:    true,
+						},
+						"inserted_at": schema.StringAttribute{
+							Description: "Datetime at which the notification recipient was inserted.",
+							Computed:    true,
+						},
+						"updated_at": schema.StringAttribute{
+							Description: "Datetime at which the notification recipient was last updated.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *notificationRecipientsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Retrieve values from config
+	var state notificationRecipientsDataSourceModel
+	diagnostics := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diagnostics...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	statusPageSubdomain := state.StatusPageSubdomain.ValueString()
+	limit := state.Limit.ValueInt64()
+	recipients := []statuspal.NotificationRecipient{}
+	err := d.client.ListNotificationRecipients(ctx, &statusPageSubdomain, func(page []statuspal.NotificationRecipient) bool {
+		recipients = append(recipients, page...)
+		return limit == 0 || int64(len(recipients)) < limit
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read StatusPal Notification Recipients",
+			err.Error(),
+		)
+		return
+	}
+
+	if limit > 0 && int64(len(recipients)) > limit {
+		recipients = recipients[:limit]
+	}
+
+	// Map response body to model
+	state.NotificationRecipients = make([]notificationRecipientModel, 0, len(recipients))
+	for i := range recipients {
+		state.NotificationRecipients = append(state.NotificationRecipients, *mapResponseToNotificationRecipientModel(&recipients[i]))
+	}
+	state.ID = types.StringValue("placeholder") // only for test case
+
+	// Set state
+	diagnostics = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diagnostics...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *notificationRecipientsDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*statuspal.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf(
+				"Expected *statuspal.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	d.client = client
+}
@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccNotificationRecipientsDataSource(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /status_pages/example-com/notification_recipients", func(w http.ResponseWriter, r *http.Request) {
+		if _, err := w.Write([]byte(`{
+			"notification_recipients": [
+				{
+					"id": 1,
+					"email": "oncall@example.com",
+					"name": "On-call",
+					"inserted_at": "2024-05-16T10:00:00",
+					"updated_at": "2024-05-16T10:00:00"
+				},
+				{
+					"id": 2,
+					"email": "sre@example.com",
+					"inserted_at": "2024-05-16T10:00:00",
+					"updated_at": "2024-05-16T10:00:00"
+				}
+			],
+			"links": {"prev": null, "next": null}
+		}`)); err != nil {
+			log.Printf(`Error writing "/status_pages/example-com/notification_recipients" response: %v`, err)
+		}
+	})
+	mock := httptest.NewServer(mux)
+	defer mock.Close()
+
+	providerConfig := *providerConfig(&mock.URL)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `
+data "statuspal_notification_recipients" "test" {
+  status_page_subdomain = "example-com"
+}
+
+data "statuspal_notification_recipients" "limited" {
+  status_page_subdomain = "example-com"
+  limit                 = 1
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.statuspal_notification_recipients.test", "notification_recipients.#", "2"),
+					resource.TestCheckResourceAttr("data.statuspal_notification_recipients.test", "notification_recipients.0.id", "1"),
+					resource.TestCheckResourceAttr("data.statuspal_notification_recipients.test", "notification_recipients.0.email", "oncall@example.com"),
+					resource.TestCheckResourceAttr("data.statuspal_notification_recipients.test", "notification_recipients.0.name", "On-call"),
+					resource.TestCheckResourceAttr("data.statuspal_notification_recipients.test", "notification_recipients.1.email", "sre@example.com"),
+					resource.TestCheckNoResourceAttr("data.statuspal_notification_recipients.test", "notification_recipients.1.name"),
+					resource.TestCheckResourceAttr("data.statuspal_notification_recipients.limited", "notification_recipients.#", "1"),
+				),
+			},
+		},
+	})
+}
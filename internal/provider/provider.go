@@ -2,11 +2,16 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"net/url"
 	"os"
+	"regexp"
+	"strconv"
 	"strings"
 
 	statuspal "terraform-provider-statuspal/internal/client"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/function"
@@ -19,6 +24,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
+// baseURLRegexp matches the http and https URLs accepted as base URL or proxy URL.
+var baseURLRegexp = regexp.MustCompile(`^https?://[^\s/?#]+[^\s]*$`)
+
 // Ensure the implementation satisfies the expected interfaces.
 var (
 	_ provider.Provider              = &statuspalProvider{}
@@ -44,16 +52,16 @@ type statuspalProvider struct {
 
 // statuspalProviderModel maps provider schema data to a Go type.
 type statuspalProviderModel struct {
-	ApiKey types.String `tfsdk:"api_key"`
-	Region types.String `tfsdk:"region"`
-}
-
-type statuspalProviderDevModel struct {
-	ApiKey types.String `tfsdk:"api_key"`
-}
-
-type statuspalProviderTestModel struct {
-	TestUrl types.String `tfsdk:"test_url"`
+	ApiKey               types.String  `tfsdk:"api_key"`
+	Region               types.String  `tfsdk:"region"`
+	SkipRegionValidation types.Bool    `tfsdk:"skip_region_validation"`
+	MaxRetries           types.Int64   `tfsdk:"max_retries"`
+	RequestsPerSecond    types.Float64 `tfsdk:"requests_per_second"`
+	Burst                types.Int64   `tfsdk:"burst"`
+	BaseURL              types.String  `tfsdk:"base_url"`
+	HTTPProxy            types.String  `tfsdk:"http_proxy"`
+	CABundle             types.String  `tfsdk:"ca_bundle"`
+	InsecureSkipVerify   types.Bool    `tfsdk:"insecure_skip_verify"`
 }
 
 // Metadata returns the provider type name.
@@ -64,35 +72,79 @@ func (p *statuspalProvider) Metadata(_ context.Context, _ provider.MetadataReque
 
 // Schema defines the provider-level schema for configuration data.
 func (p *statuspalProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
-	env := os.Getenv("TF_ENV")
-
-	attributes := map[string]schema.Attribute{}
-
-	if env == "DEV" || env != "TEST" {
-		attributes["api_key"] = schema.StringAttribute{
-			MarkdownDescription: "Your StatusPal User or Organization API Key. May also be provided via `STATUSPAL_API_KEY` environment variable.",
-			Optional:            true,
-			Sensitive:           true,
-		}
-
-		if env != "DEV" {
-			attributes["region"] = schema.StringAttribute{
-				MarkdownDescription: "StatusPal API Region, it can be \"US\" and \"EU\". May also be provided via `STATUSPAL_REGION` environment variable.",
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Interact with [StatusPal](https://www.statuspal.io).",
+		Attributes: map[string]schema.Attribute{
+			"api_key": schema.StringAttribute{
+				MarkdownDescription: "Your StatusPal User or Organization API Key. May also be provided via `STATUSPAL_API_KEY` environment variable.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "StatusPal API Region, it can be \"US\" and \"EU\". Not required when `base_url` is set. May also be provided via `STATUSPAL_REGION` environment variable.",
 				Optional:            true,
 				Validators: []validator.String{
 					stringvalidator.OneOf("EU", "US"),
 				},
-			}
-		}
-	} else {
-		attributes["test_url"] = schema.StringAttribute{
-			Required: true,
-		}
-	}
-
-	resp.Schema = schema.Schema{
-		MarkdownDescription: "Interact with [StatusPal](https://www.statuspal.io).",
-		Attributes:          attributes,
+			},
+			"skip_region_validation": schema.BoolAttribute{
+				MarkdownDescription: "Skip the validation of the `region`, which is then not required. " +
+					"Without `region` nor `base_url`, the provider reaches a local development StatusPal instance. " +
+					"May also be provided via `STATUSPAL_SKIP_REGION_VALIDATION` environment variable. Defaults to `false`.",
+				Optional: true,
+			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of retries of a failed StatusPal API request. " +
+					"Requests are retried with an exponential backoff on rate limiting (`429`), server errors (`5xx`) and connection errors. " +
+					"Set it to `0` to disable the retries. Defaults to `4`.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"requests_per_second": schema.Float64Attribute{
+				MarkdownDescription: "Maximum number of requests per second sent to the StatusPal API by this provider configuration, e.g. `0.5` for one request every two seconds. " +
+					"The limit is lowered automatically when the API reports, through its rate limit headers, that fewer requests are left. Defaults to `10`.",
+				Optional: true,
+				Validators: []validator.Float64{
+					positiveFloat64Validator{},
+				},
+			},
+			"burst": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of requests sent to the StatusPal API at once, before `requests_per_second` applies. Defaults to `10`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"base_url": schema.StringAttribute{
+				MarkdownDescription: "Base URL of the StatusPal API, e.g. `https://statuspal.example.com/api/v2`. " +
+					"Use it to reach a self-hosted or staging StatusPal instead of the API of the `region`, which is then not required. " +
+					"May also be provided via `STATUSPAL_API_URL` environment variable.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(baseURLRegexp, "must be a valid http or https URL"),
+				},
+			},
+			"http_proxy": schema.StringAttribute{
+				MarkdownDescription: "URL of the HTTP proxy used to reach the StatusPal API, e.g. `http://proxy.example.com:3128`. " +
+					"Defaults to the proxy defined by the `HTTP_PROXY`, `HTTPS_PROXY` and `NO_PROXY` environment variables.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(baseURLRegexp, "must be a valid http or https URL"),
+				},
+			},
+			"ca_bundle": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded CA certificates trusted, in addition to the system ones, when connecting to the StatusPal API " +
+					"or to the `http_proxy`, e.g. `file(\"ca.pem\")`.",
+				Optional: true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				MarkdownDescription: "Skip the verification of the StatusPal API TLS certificate. " +
+					"**Never enable it in production**, it is only meant for testing against instances with self-signed certificates.",
+				Optional: true,
+			},
+		},
 	}
 }
 
@@ -100,149 +152,119 @@ func (p *statuspalProvider) Schema(_ context.Context, _ provider.SchemaRequest,
 func (p *statuspalProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
 	tflog.Info(ctx, "Configuring StatusPal client")
 
-	env := os.Getenv("TF_ENV")
-
-	var api_key string
-	var region string
-	var test_url string
-
-	if env == "DEV" {
-		// Retrieve provider data from configuration
-		var config statuspalProviderDevModel
-		diags := req.Config.Get(ctx, &config)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-
-		// If practitioner provided a configuration value for any of the
-		// attributes, it must be a known value.
-
-		if config.ApiKey.IsUnknown() {
-			resp.Diagnostics.AddAttributeError(
-				path.Root("api_key"),
-				"Unknown StatusPal API Key",
-				"The provider cannot create the StatusPal API client as there is an unknown configuration value for the StatusPal API key. "+
-					"Either target apply the source of the value first, set the value statically in the configuration, or use the STATUSPAL_API_KEY environment variable.",
-			)
-			return
-		}
-
-		// Default values to environment variables, but override
-		// with Terraform configuration value if set.
+	// Retrieve provider data from configuration
+	var config statuspalProviderModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-		api_key = os.Getenv("STATUSPAL_API_KEY")
+	// If practitioner provided a configuration value for any of the
+	// attributes, it must be a known value.
 
-		if !config.ApiKey.IsNull() {
-			api_key = config.ApiKey.ValueString()
-		}
+	if config.ApiKey.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("api_key"),
+			"Unknown StatusPal API Key",
+			"The provider cannot create the StatusPal API client as there is an unknown configuration value for the StatusPal API key. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the STATUSPAL_API_KEY environment variable.",
+		)
+	}
 
-		// If any of the expected configurations are missing, return
-		// errors with provider-specific guidance.
-
-		if api_key == "" {
-			resp.Diagnostics.AddAttributeError(
-				path.Root("api_key"),
-				"Missing StatusPal API Key",
-				"The provider cannot create the StatusPal API client as there is a missing or empty value for the StatusPal API key. "+
-					"Set the api key value in the configuration or use the STATUSPAL_API_KEY environment variable. "+
-					"If either is already set, ensure the value is not empty.",
-			)
-			return
-		}
+	if config.Region.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("region"),
+			"Unknown StatusPal API Region",
+			"The provider cannot create the StatusPal API client as there is an unknown configuration value for the StatusPal API region. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the STATUSPAL_REGION environment variable.",
+		)
+	}
 
-		ctx = tflog.SetField(ctx, "api_key", api_key)
-	} else if env == "TEST" {
-		// Retrieve provider data from configuration
-		var config statuspalProviderTestModel
-		diags := req.Config.Get(ctx, &config)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
+	if config.BaseURL.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("base_url"),
+			"Unknown StatusPal API Base URL",
+			"The provider cannot create the StatusPal API client as there is an unknown configuration value for the StatusPal API base URL. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the STATUSPAL_API_URL environment variable.",
+		)
+	}
 
-		test_url = config.TestUrl.ValueString()
-	} else {
-		// Retrieve provider data from configuration
-		var config statuspalProviderModel
-		diags := req.Config.Get(ctx, &config)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-		// If practitioner provided a configuration value for any of the
-		// attributes, it must be a known value.
-
-		if config.ApiKey.IsUnknown() {
-			resp.Diagnostics.AddAttributeError(
-				path.Root("api_key"),
-				"Unknown StatusPal API Key",
-				"The provider cannot create the StatusPal API client as there is an unknown configuration value for the StatusPal API key. "+
-					"Either target apply the source of the value first, set the value statically in the configuration, or use the STATUSPAL_API_KEY environment variable.",
-			)
-			return
-		}
+	// Default values to environment variables, but override
+	// with Terraform configuration value if set.
 
-		if config.Region.IsUnknown() {
-			resp.Diagnostics.AddAttributeError(
-				path.Root("region"),
-				"Unknown StatusPal API Region",
-				"The provider cannot create the StatusPal API client as there is an unknown configuration value for the StatusPal API region. "+
-					"Either target apply the source of the value first, set the value statically in the configuration, or use the STATUSPAL_REGION environment variable.",
-			)
-			return
-		}
+	api_key := os.Getenv("STATUSPAL_API_KEY")
+	region := os.Getenv("STATUSPAL_REGION")
+	base_url := os.Getenv("STATUSPAL_API_URL")
+	skipRegionValidation, _ := strconv.ParseBool(os.Getenv("STATUSPAL_SKIP_REGION_VALIDATION"))
 
-		// Default values to environment variables, but override
-		// with Terraform configuration value if set.
+	if !config.ApiKey.IsNull() {
+		api_key = config.ApiKey.ValueString()
+	}
 
-		api_key = os.Getenv("STATUSPAL_API_KEY")
-		region = os.Getenv("STATUSPAL_REGION")
+	if !config.Region.IsNull() {
+		region = config.Region.ValueString()
+	}
 
-		if !config.ApiKey.IsNull() {
-			api_key = config.ApiKey.ValueString()
-		}
+	if !config.BaseURL.IsNull() {
+		base_url = config.BaseURL.ValueString()
+	}
 
-		if !config.Region.IsNull() {
-			region = config.Region.ValueString()
-		}
+	if !config.SkipRegionValidation.IsNull() && !config.SkipRegionValidation.IsUnknown() {
+		skipRegionValidation = config.SkipRegionValidation.ValueBool()
+	}
 
-		region = strings.ToUpper(region)
+	region = strings.ToUpper(region)
 
-		// If any of the expected configurations are missing, return
-		// errors with provider-specific guidance.
+	// If any of the expected configurations are missing, return
+	// errors with provider-specific guidance.
 
-		if api_key == "" {
-			resp.Diagnostics.AddAttributeError(
-				path.Root("api_key"),
-				"Missing StatusPal API Key",
-				"The provider cannot create the StatusPal API client as there is a missing or empty value for the StatusPal API key. "+
-					"Set the api key value in the configuration or use the STATUSPAL_API_KEY environment variable. "+
-					"If either is already set, ensure the value is not empty.",
-			)
-			return
-		}
+	if api_key == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("api_key"),
+			"Missing StatusPal API Key",
+			"The provider cannot create the StatusPal API client as there is a missing or empty value for the StatusPal API key. "+
+				"Set the api key value in the configuration or use the STATUSPAL_API_KEY environment variable. "+
+				"If either is already set, ensure the value is not empty.",
+		)
+		return
+	}
 
-		if region != "EU" && region != "US" {
-			resp.Diagnostics.AddAttributeError(
-				path.Root("region"),
-				"Missing or Invalid StatusPal API Region",
-				"The provider cannot create the StatusPal API client as there is a missing, empty or invalid value for the StatusPal API region. "+
-					"Set the region value in the configuration or use the STATUSPAL_REGION environment variable. "+
-					`If either is already set, ensure the value is not empty and it can be only "EU" or "US".`,
-			)
-			return
-		}
+	// The region is only required to build the API URL when no base URL is set.
+	if !skipRegionValidation && (region != "" || base_url == "") && region != "EU" && region != "US" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("region"),
+			"Missing or Invalid StatusPal API Region",
+			"The provider cannot create the StatusPal API client as there is a missing, empty or invalid value for the StatusPal API region. "+
+				"Set the region value in the configuration or use the STATUSPAL_REGION environment variable, or set the base_url instead. "+
+				`If either is already set, ensure the value is not empty and it can be only "EU" or "US".`,
+		)
+		return
+	}
 
-		ctx = tflog.SetField(ctx, "api_key", api_key)
-		ctx = tflog.SetField(ctx, "region", region)
+	if base_url != "" && !isValidBaseURL(base_url) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("base_url"),
+			"Invalid StatusPal API Base URL",
+			"The provider cannot create the StatusPal API client as the StatusPal API base URL is not a valid http or https URL. "+
+				"Set a valid base_url value in the configuration or in the STATUSPAL_API_URL environment variable.",
+		)
+		return
 	}
 
+	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "api_key")
+	ctx = tflog.MaskAllFieldValuesStrings(ctx, api_key)
+	ctx = tflog.SetField(ctx, "region", region)
+	ctx = tflog.SetField(ctx, "base_url", base_url)
+
 	tflog.Debug(ctx, "Creating StatusPal client")
 
 	// Create a new StatusPal client using the configuration values
-	client, err := statuspal.NewClient(&api_key, &region, &test_url)
+	client, err := statuspal.NewClient(&api_key, &region, &base_url)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Create StatusPal API Client",
@@ -253,6 +275,43 @@ func (p *statuspalProvider) Configure(ctx context.Context, req provider.Configur
 		return
 	}
 
+	if !config.MaxRetries.IsNull() && !config.MaxRetries.IsUnknown() {
+		client.RetryMax = int(config.MaxRetries.ValueInt64())
+	}
+
+	rateLimit, burstLimit := float64(statuspal.RateLimit), int64(statuspal.BurstLimit)
+	if !config.RequestsPerSecond.IsNull() && !config.RequestsPerSecond.IsUnknown() {
+		rateLimit = config.RequestsPerSecond.ValueFloat64()
+	}
+	if !config.Burst.IsNull() && !config.Burst.IsUnknown() {
+		burstLimit = config.Burst.ValueInt64()
+	}
+	client.SetRateLimit(rateLimit, int(burstLimit))
+
+	transportConfig := statuspal.TransportConfig{
+		ProxyURL:           config.HTTPProxy.ValueString(),
+		CABundle:           config.CABundle.ValueString(),
+		InsecureSkipVerify: config.InsecureSkipVerify.ValueBool(),
+	}
+
+	if err := client.ConfigureTransport(transportConfig); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Configure StatusPal API Client Transport",
+			"The provider cannot create the StatusPal API client as its HTTP transport configuration is invalid. "+
+				"Check the http_proxy and ca_bundle values in the configuration.\n\n"+
+				"StatusPal Client Error: "+err.Error(),
+		)
+		return
+	}
+
+	if transportConfig.InsecureSkipVerify {
+		resp.Diagnostics.AddWarning(
+			"StatusPal API TLS Certificate Verification Disabled",
+			"The insecure_skip_verify attribute is enabled, the TLS certificate of the StatusPal API is not verified. "+
+				"Never enable it in production.",
+		)
+	}
+
 	// Make the StatusPal client available during DataSource and Resource
 	// type Configure methods.
 	resp.DataSourceData = client
@@ -261,12 +320,29 @@ func (p *statuspalProvider) Configure(ctx context.Context, req provider.Configur
 	tflog.Info(ctx, "Configured StatusPal client", map[string]any{"success": true})
 }
 
+// isValidBaseURL checks that the value is an absolute http or https URL.
+func isValidBaseURL(value string) bool {
+	parsed, err := url.Parse(value)
+	if err != nil {
+		return false
+	}
+
+	return (parsed.Scheme == "http" || parsed.Scheme == "https") && parsed.Host != ""
+}
+
 // DataSources defines the data sources implemented in the provider.
 func (p *statuspalProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewStatusPagesDataSource,
+		NewStatusPageDataSource,
+		NewStatusSummaryDataSource,
 		NewServicesDataSource,
+		NewServiceDataSource,
+		NewServiceTreeDataSource,
 		NewMetricsDataSource,
+		NewNotificationRecipientsDataSource,
+		NewTeamMembersDataSource,
+		NewMetricIntegrationsDataSource,
 	}
 }
 
@@ -278,6 +354,23 @@ func (p *statuspalProvider) Resources(_ context.Context) []func() resource.Resou
 		NewMetricResource,
 		NewDomainSslRecordsResource,
 		NewCustomDomainValidationResource,
+		NewIncidentResource,
+		NewMaintenanceResource,
+		NewIncidentUpdateResource,
+		NewSubscriberResource,
+		NewSubscribersResource,
+		NewNotificationRecipientResource,
+		NewTeamMemberResource,
+		NewWebhookResource,
+		NewSlackIntegrationResource,
+		NewTeamsIntegrationResource,
+		NewDiscordIntegrationResource,
+		NewGoogleChatIntegrationResource,
+		NewMattermostIntegrationResource,
+		NewMetricEntryResource,
+		NewMetricIntegrationResource,
+		NewIncidentTypeResource,
+		NewNoticeResource,
 	}
 }
 
@@ -286,3 +379,31 @@ func (p *statuspalProvider) Functions(_ context.Context) []func() function.Funct
 		NewDemoSumFunction,
 	}
 }
+
+// positiveFloat64Validator validates that a float64 attribute value is greater than 0.
+type positiveFloat64Validator struct{}
+
+// Description describes the validation in plain text formatting.
+func (v positiveFloat64Validator) Description(_ context.Context) string {
+	return "value must be greater than 0"
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v positiveFloat64Validator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateFloat64 performs the validation.
+func (v positiveFloat64Validator) ValidateFloat64(ctx context.Context, req validator.Float64Request, resp *validator.Float64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if value := req.ConfigValue.ValueFloat64(); value <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute %s %s, got: %s", req.Path, v.Description(ctx), strconv.FormatFloat(value, 'f', -1, 64)),
+		)
+	}
+}
@@ -9,7 +9,8 @@ import (
 func providerConfig(testUrl *string) *string {
 	providerConfig := `
 		provider "statuspal" {
-			test_url = "` + *testUrl + `"
+			api_key  = "test"
+			base_url = "` + *testUrl + `"
 		}
 	`
 	return &providerConfig
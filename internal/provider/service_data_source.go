@@ -0,0 +1,231 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	statuspal "terraform-provider-statuspal/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &serviceDataSource{}
+	_ datasource.DataSourceWithConfigure = &serviceDataSource{}
+)
+
+// NewServiceDataSource is a helper function to simplify the provider implementation.
+func NewServiceDataSource() datasource.DataSource {
+	return &serviceDataSource{}
+}
+
+// serviceDataSource is the data source implementation.
+type serviceDataSource struct {
+	client *statuspal.Client
+}
+
+// serviceDataSourceModel maps the data source schema data.
+type serviceDataSourceModel struct {
+	ID                  types.String  `tfsdk:"id"` // only for test case
+	StatusPageSubdomain types.String  `tfsdk:"status_page_subdomain"`
+	ServiceID           types.String  `tfsdk:"service_id"`
+	Name                types.String  `tfsdk:"name"`
+	ParentName          types.String  `tfsdk:"parent_name"`
+	RequireUnique       types.Bool    `tfsdk:"require_unique"`
+	Service             *serviceModel `tfsdk:"service"`
+}
+
+// Metadata returns the data source type name.
+func (d *serviceDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_service"
+}
+
+// Schema defines the schema for the data source.
+func (d *serviceDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches a single service of the status page, looked up by its ID or by its name and optionally the name of its parent.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier attribute. Ignore it, only used in testing.",
+				Computed:    true,
+			},
+			"status_page_subdomain": schema.StringAttribute{
+				Description: "The status page subdomain of the service.",
+				Required:    true,
+			},
+			"service_id": schema.StringAttribute{
+				Description: "The ID of the service to look up. Exactly one of service_id or name must be set.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(
+						path.MatchRoot("service_id"),
+						path.MatchRoot("name"),
+					),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The exact name of the service to look up.",
+				Optional:    true,
+			},
+			"parent_name": schema.StringAttribute{
+				Description: "The exact name of the parent of the service to look up, to tell apart services with the same name under different parents.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("name")),
+				},
+			},
+			"require_unique": schema.BoolAttribute{
+				Description: "Fail when several services match the name (and parent name) instead of returning the first one in the services order. Defaults to false.",
+				Optional:    true,
+			},
+			"service": schema.SingleNestedAttribute{
+				Description: "The service found.",
+				Computed:    true,
+				Attributes:  serviceDataSourceAttributes(),
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *serviceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Retrieve values from config
+	var state serviceDataSourceModel
+	diagnostics := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diagnostics...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subdomain := state.StatusPageSubdomain.ValueString()
+	var service *statuspal.Service
+	if !state.ServiceID.IsNull() {
+		serviceID := state.ServiceID.ValueString()
+		found, err := d.client.GetService(ctx, &subdomain, &serviceID)
+		if statuspal.ErrorNotFound(err) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("service_id"),
+				"StatusPal Service Not Found",
+				fmt.Sprintf("No service with the ID %q was found in the status page %q.", serviceID, subdomain),
+			)
+			return
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read StatusPal Service",
+				err.Error(),
+			)
+			return
+		}
+		service = found
+	} else {
+		services, err := d.client.GetServices(ctx, &subdomain)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read StatusPal Service",
+				err.Error(),
+			)
+			return
+		}
+
+		names := make(map[int64]string, len(*services))
+		for _, service := range *services {
+			names[service.ID] = service.Name
+		}
+
+		matches := []statuspal.Service{}
+		for _, service := range *services {
+			if service.Name != state.Name.ValueString() {
+				continue
+			}
+			if !state.ParentName.IsNull() && (service.ParentID == nil || names[*service.ParentID] != state.ParentName.ValueString()) {
+				continue
+			}
+			matches = append(matches, service)
+		}
+
+		description := fmt.Sprintf("the name %q", state.Name.ValueString())
+		if !state.ParentName.IsNull() {
+			description += fmt.Sprintf(" under the parent %q", state.ParentName.ValueString())
+		}
+
+		if len(matches) == 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name"),
+				"StatusPal Service Not Found",
+				fmt.Sprintf("No service with %s was found in the status page %q.", description, subdomain),
+			)
+			return
+		}
+		if len(matches) > 1 && state.RequireUnique.ValueBool() {
+			ids := make([]string, 0, len(matches))
+			for _, match := range matches {
+				ids = append(ids, strconv.FormatInt(match.ID, 10))
+			}
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name"),
+				"Multiple StatusPal Services Found",
+				fmt.Sprintf(
+					"%d services with %s were found in the status page %q (IDs: %s). Set the parent_name or look the service up by its ID.",
+					len(matches), description, subdomain, strings.Join(ids, ", "),
+				),
+			)
+			return
+		}
+		service = &matches[0]
+	}
+
+	// Map response body to model
+	state.Service = mapResponseToServiceModel(&ctx, service, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.ID = types.StringValue("placeholder") // only for test case
+
+	// Set state
+	diagnostics = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diagnostics...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *serviceDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*statuspal.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf(
+				"Expected *statuspal.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	d.client = client
+}
@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccServiceDataSource(t *testing.T) {
+	mux := http.NewServeMux()
+	// Two "API" services under different parents
+	mux.HandleFunc("GET /status_pages/example-com/services", func(w http.ResponseWriter, r *http.Request) {
+		if _, err := w.Write([]byte(`{
+			"links": {"next": null, "prev": null},
+			"services": [
+				{"id": 1, "name": "Backend", "parent_id": null, "is_up": true, "children_ids": [3, 4], "order": 1},
+				{"id": 2, "name": "Frontend", "parent_id": null, "is_up": true, "children_ids": [5], "order": 2},
+				{"id": 3, "name": "API", "parent_id": 1, "is_up": true, "monitoring": "internal", "order": 3},
+				{"id": 4, "name": "Database", "parent_id": 1, "is_up": false, "current_incident_type": "major", "private": true, "order": 4},
+				{"id": 5, "name": "API", "parent_id": 2, "is_up": true, "order": 5}
+			]
+		}`)); err != nil {
+			log.Printf(`Error writing "/status_pages/example-com/services" response: %v`, err)
+		}
+	})
+	mux.HandleFunc("GET /status_pages/example-com/services/4", func(w http.ResponseWriter, r *http.Request) {
+		if _, err := w.Write([]byte(`{
+			"service": {"id": 4, "name": "Database", "parent_id": 1, "is_up": false, "current_incident_type": "major", "private": true, "order": 4}
+		}`)); err != nil {
+			log.Printf(`Error writing "/status_pages/example-com/services/4" response: %v`, err)
+		}
+	})
+	mock := httptest.NewServer(mux)
+	defer mock.Close()
+
+	providerConfig := *providerConfig(&mock.URL)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Parent name without name error testing
+			{
+				Config: providerConfig + `
+data "statuspal_service" "test" {
+  status_page_subdomain = "example-com"
+  service_id            = "3"
+  parent_name           = "Backend"
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			// Not found error testing
+			{
+				Config: providerConfig + `
+data "statuspal_service" "test" {
+  status_page_subdomain = "example-com"
+  service_id            = "42"
+}
+`,
+				ExpectError: regexp.MustCompile(`StatusPal Service Not Found`),
+			},
+			{
+				Config: providerConfig + `
+data "statuspal_service" "test" {
+  status_page_subdomain = "example-com"
+  name                  = "API"
+  parent_name           = "Database"
+}
+`,
+				ExpectError: regexp.MustCompile(`StatusPal Service Not Found`),
+			},
+			// Uniqueness error testing
+			{
+				Config: providerConfig + `
+data "statuspal_service" "test" {
+  status_page_subdomain = "example-com"
+  name                  = "API"
+  require_unique        = true
+}
+`,
+				ExpectError: regexp.MustCompile(`Multiple StatusPal Services Found`),
+			},
+			// Read testing
+			{
+				Config: providerConfig + `
+data "statuspal_service" "by_id" {
+  status_page_subdomain = "example-com"
+  service_id            = "4"
+}
+
+data "statuspal_service" "by_name" {
+  status_page_subdomain = "example-com"
+  name                  = "API"
+}
+
+data "statuspal_service" "by_parent_name" {
+  status_page_subdomain = "example-com"
+  name                  = "API"
+  parent_name           = "Frontend"
+  require_unique        = true
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.statuspal_service.by_id", "service.name", "Database"),
+					resource.TestCheckResourceAttr("data.statuspal_service.by_id", "service.parent_id", "1"),
+					resource.TestCheckResourceAttr("data.statuspal_service.by_id", "service.is_up", "false"),
+					resource.TestCheckResourceAttr("data.statuspal_service.by_id", "service.current_incident_type", "major"),
+					resource.TestCheckResourceAttr("data.statuspal_service.by_name", "service.id", "3"),
+					resource.TestCheckResourceAttr("data.statuspal_service.by_name", "service.monitoring", "internal"),
+					resource.TestCheckResourceAttr("data.statuspal_service.by_parent_name", "service.id", "5"),
+					resource.TestCheckResourceAttr("data.statuspal_service.by_parent_name", "service.parent_id", "2"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// parseServiceIDs converts a list of service IDs to the IDs sent to the API.
+func parseServiceIDs(ctx context.Context, list types.List, diagnostics *diag.Diagnostics) []int64 {
+	serviceIDs := []int64{}
+	if list.IsNull() || list.IsUnknown() {
+		return serviceIDs
+	}
+
+	var values []string
+	diagnostics.Append(list.ElementsAs(ctx, &values, false)...)
+	if diagnostics.HasError() {
+		return nil
+	}
+
+	for _, value := range values {
+		serviceID, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			diagnostics.AddError("Not valid service ID", err.Error())
+
+			return nil
+		}
+
+		serviceIDs = append(serviceIDs, serviceID)
+	}
+
+	return serviceIDs
+}
+
+// mapServiceIDsToList converts the service IDs returned by the API to a list.
+// The previous list is kept when it holds the same IDs, as the API doesn't
+// keep their order.
+func mapServiceIDsToList(ctx context.Context, previous types.List, serviceIDs []int64, diagnostics *diag.Diagnostics) types.List {
+	values := make([]string, 0, len(serviceIDs))
+	for _, serviceID := range serviceIDs {
+		values = append(values, strconv.FormatInt(serviceID, 10))
+	}
+	sort.Strings(values)
+
+	if !previous.IsNull() && !previous.IsUnknown() {
+		var previousValues []string
+		diagnostics.Append(previous.ElementsAs(ctx, &previousValues, false)...)
+
+		sortedPreviousValues := append([]string{}, previousValues...)
+		sort.Strings(sortedPreviousValues)
+		if strings.Join(sortedPreviousValues, ",") == strings.Join(values, ",") {
+			return previous
+		}
+	}
+
+	list, diags := types.ListValueFrom(ctx, types.StringType, values)
+	diagnostics.Append(diags...)
+
+	return list
+}
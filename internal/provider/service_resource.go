@@ -157,14 +157,12 @@ func (r *serviceResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 						Default:     stringdefault.StaticString(""),
 					},
 					"current_incident_type": schema.StringAttribute{
-						MarkdownDescription: "Enum: `\"minor\"` `\"major\"` `\"scheduled\"`\n  The service's current incident type.\n  The type of the (current) incident:\n" +
+						MarkdownDescription: "The service's current incident type.\n  The type of the (current) incident:\n" +
 							"  - `minor` - A minor incident is currently taking place.\n" +
 							"  - `major` - A major incident is currently taking place.\n" +
-							"  - `scheduled` - A scheduled maintenance is currently taking place.",
+							"  - `scheduled` - A scheduled maintenance is currently taking place.\n" +
+							"  - the ID of a custom incident type - When the `custom_incident_types_enabled` attribute of the status page is enabled.",
 						Computed: true,
-						Validators: []validator.String{
-							stringvalidator.OneOf("minor", "major", "scheduled"),
-						},
 					},
 					"monitoring": schema.StringAttribute{
 						MarkdownDescription: "Enum: `\"\"` `\"internal\"` `\"3rd_party\"` `\"webhook\"`\n  Monitoring types:\n" +
@@ -277,22 +275,18 @@ func (r *serviceResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 						Default:     stringdefault.StaticString(""),
 					},
 					"incident_type": schema.StringAttribute{
-						MarkdownDescription: "Enum: `\"minor\"` `\"major\"`\n  Sets the incident type to this value when an incident is created via monitoring.\n  The type of the (current) incident:\n" +
+						MarkdownDescription: "Sets the incident type to this value when an incident is created via monitoring.\n  The type of the (current) incident:\n" +
 							"  - `minor` - A minor incident is currently taking place.\n" +
-							"  - `major` - A major incident is currently taking place.",
+							"  - `major` - A major incident is currently taking place.\n" +
+							"  - the ID of a custom incident type - When the `custom_incident_types_enabled` attribute of the status page is enabled.",
 						Computed: true,
-						Validators: []validator.String{
-							stringvalidator.OneOf("minor", "major"),
-						},
 					},
 					"parent_incident_type": schema.StringAttribute{
-						MarkdownDescription: "Enum: `\"minor\"` `\"major\"`\n  Sets the parent's service incident type to this value when an incident is created via monitoring.\n  The type of the (current) incident:\n" +
+						MarkdownDescription: "Sets the parent's service incident type to this value when an incident is created via monitoring.\n  The type of the (current) incident:\n" +
 							"  - `minor` - A minor incident is currently taking place.\n" +
-							"  - `major` - A major incident is currently taking place.",
+							"  - `major` - A major incident is currently taking place.\n" +
+							"  - the ID of a custom incident type - When the `custom_incident_types_enabled` attribute of the status page is enabled.",
 						Computed: true,
-						Validators: []validator.String{
-							stringvalidator.OneOf("minor", "major"),
-						},
 					},
 					"is_up": schema.BoolAttribute{
 						Description: "Is the monitored service up?",
@@ -403,11 +397,12 @@ func (r *serviceResource) Create(ctx context.Context, req resource.CreateRequest
 
 	// Create new service
 	statusPageSubdomain := plan.StatusPageSubdomain.ValueString()
-	newService, err := r.client.CreateService(service, &statusPageSubdomain)
+	newService, err := r.client.CreateService(ctx, service, &statusPageSubdomain)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addClientError(&resp.Diagnostics, path.Root("service"),
 			"Error creating StatusPal Service",
-			"Could not create service, unexpected error: "+err.Error(),
+			"Could not create service, unexpected error: ",
+			err,
 		)
 		return
 	}
@@ -441,7 +436,7 @@ func (r *serviceResource) Read(ctx context.Context, req resource.ReadRequest, re
 	// Get refreshed service value from StatusPal
 	statusPageSubdomain := state.StatusPageSubdomain.ValueString()
 	serviceID := state.Service.ID.ValueString()
-	service, err := r.client.GetService(&statusPageSubdomain, &serviceID)
+	service, err := r.client.GetService(ctx, &statusPageSubdomain, &serviceID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading StatusPal Service",
@@ -485,11 +480,12 @@ func (r *serviceResource) Update(ctx context.Context, req resource.UpdateRequest
 	// Update existing service
 	statusPageSubdomain := plan.StatusPageSubdomain.ValueString()
 	serviceID := plan.Service.ID.ValueString()
-	updatedService, err := r.client.UpdateService(service, &statusPageSubdomain, &serviceID)
+	updatedService, err := r.client.UpdateService(ctx, service, &statusPageSubdomain, &serviceID)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addClientError(&resp.Diagnostics, path.Root("service"),
 			"Error Updating StatusPal Service",
-			"Could not Update service, unexpected error: "+err.Error(),
+			"Could not Update service, unexpected error: ",
+			err,
 		)
 		return
 	}
@@ -523,7 +519,7 @@ func (r *serviceResource) Delete(ctx context.Context, req resource.DeleteRequest
 	// Delete existing order
 	statusPageSubdomain := state.StatusPageSubdomain.ValueString()
 	serviceID := state.Service.ID.ValueString()
-	err := r.client.DeleteService(&statusPageSubdomain, &serviceID)
+	err := r.client.DeleteService(ctx, &statusPageSubdomain, &serviceID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Deleting StatusPal Service",
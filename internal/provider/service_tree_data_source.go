@@ -0,0 +1,382 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	statuspal "terraform-provider-statuspal/internal/client"
+)
+
+// servicePathSeparator separates the names of the ancestors of a service in its path.
+const servicePathSeparator = " / "
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &serviceTreeDataSource{}
+	_ datasource.DataSourceWithConfigure = &serviceTreeDataSource{}
+)
+
+// NewServiceTreeDataSource is a helper function to simplify the provider implementation.
+func NewServiceTreeDataSource() datasource.DataSource {
+	return &serviceTreeDataSource{}
+}
+
+// serviceTreeDataSource is the data source implementation.
+type serviceTreeDataSource struct {
+	client *statuspal.Client
+}
+
+// serviceTreeDataSourceModel maps the data source schema data.
+type serviceTreeDataSourceModel struct {
+	ID                  types.String           `tfsdk:"id"` // only for test case
+	StatusPageSubdomain types.String           `tfsdk:"status_page_subdomain"`
+	Strict              types.Bool             `tfsdk:"strict"`
+	RootIDs             []types.String         `tfsdk:"root_ids"`
+	Services            []serviceTreeNodeModel `tfsdk:"services"`
+	Inconsistencies     []types.String         `tfsdk:"inconsistencies"`
+}
+
+// serviceTreeNodeModel maps a service of the tree.
+type serviceTreeNodeModel struct {
+	ID                  types.String   `tfsdk:"id"`
+	Name                types.String   `tfsdk:"name"`
+	ParentID            types.String   `tfsdk:"parent_id"`
+	ChildrenIDs         []types.String `tfsdk:"children_ids"`
+	Depth               types.Int64    `tfsdk:"depth"`
+	Path                types.String   `tfsdk:"path"`
+	IsUp                types.Bool     `tfsdk:"is_up"`
+	AggregatedIsUp      types.Bool     `tfsdk:"aggregated_is_up"`
+	CurrentIncidentType types.String   `tfsdk:"current_incident_type"`
+}
+
+// Metadata returns the data source type name.
+func (d *serviceTreeDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_service_tree"
+}
+
+// Schema defines the schema for the data source.
+func (d *serviceTreeDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the services of the status page resolved as a tree, from their parent IDs. " +
+			"The services are listed depth-first, each parent before its children, and the siblings in the services order.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier attribute. Ignore it, only used in testing.",
+				Computed:    true,
+			},
+			"status_page_subdomain": schema.StringAttribute{
+				Description: "The status page subdomain of the services.",
+				Required:    true,
+			},
+			"strict": schema.BoolAttribute{
+				Description: "Fail when the parent and children links of the services are inconsistent, instead of reporting a warning. Defaults to false.",
+				Optional:    true,
+			},
+			"root_ids": schema.ListAttribute{
+				Description: "IDs of the top-level services of the tree, in the services order.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"services": schema.ListNestedAttribute{
+				Description: "List of services of the tree, depth-first.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the service.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the service.",
+							Computed:    true,
+						},
+						"parent_id": schema.StringAttribute{
+							Description: "The ID of the parent of the service in the tree, empty for a top-level service.",
+							Computed:    true,
+						},
+						"children_ids": schema.ListAttribute{
+							Description: "IDs of the children of the service in the tree, in the services order.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"depth": schema.Int64Attribute{
+							Description: "The depth of the service in the tree, 0 for a top-level service.",
+							Computed:    true,
+						},
+						"path": schema.StringAttribute{
+							Description: `The names of the ancestors of the service and its own name, separated by " / " (e.g. "Backend / API").`,
+							Computed:    true,
+						},
+						"is_up": schema.BoolAttribute{
+							Description: "Is the service up?",
+							Computed:    true,
+						},
+						"aggregated_is_up": schema.BoolAttribute{
+							Description: "Are the service and all its descendants up?",
+							Computed:    true,
+						},
+						"current_incident_type": schema.StringAttribute{
+							Description: "The service's current incident type, empty when there is no ongoing incident.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"inconsistencies": schema.ListAttribute{
+				Description: "Descriptions of the inconsistent parent and children links found between the services, e.g. a child missing from the children IDs of its parent.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *serviceTreeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Retrieve values from config
+	var state serviceTreeDataSourceModel
+	diagnostics := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diagnostics...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	statusPageSubdomain := state.StatusPageSubdomain.ValueString()
+	services, err := d.client.GetServices(ctx, &statusPageSubdomain)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read StatusPal Services",
+			err.Error(),
+		)
+		return
+	}
+
+	tree := newServiceTree(*services)
+	if len(tree.inconsistencies) > 0 {
+		summary := "Inconsistent StatusPal Service Tree"
+		detail := fmt.Sprintf(
+			"The parent and children links of the services of the status page %q are inconsistent, the tree is resolved from the parent IDs:\n- %s",
+			statusPageSubdomain, strings.Join(tree.inconsistencies, "\n- "),
+		)
+		if state.Strict.ValueBool() {
+			resp.Diagnostics.AddError(summary, detail)
+			return
+		}
+		resp.Diagnostics.AddWarning(summary, detail)
+	}
+
+	// Map the tree to the model
+	state.RootIDs = []types.String{}
+	for _, root := range tree.roots {
+		state.RootIDs = append(state.RootIDs, types.StringValue(strconv.FormatInt(root.ID, 10)))
+	}
+	state.Services = []serviceTreeNodeModel{}
+	tree.walk(func(service statuspal.Service, depth int64, path []string) {
+		parentID := ""
+		if parent, ok := tree.parents[service.ID]; ok {
+			parentID = strconv.FormatInt(parent, 10)
+		}
+		childrenIDs := []types.String{}
+		for _, child := range tree.children[service.ID] {
+			childrenIDs = append(childrenIDs, types.StringValue(strconv.FormatInt(child.ID, 10)))
+		}
+
+		state.Services = append(state.Services, serviceTreeNodeModel{
+			ID:                  types.StringValue(strconv.FormatInt(service.ID, 10)),
+			Name:                types.StringValue(service.Name),
+			ParentID:            types.StringValue(parentID),
+			ChildrenIDs:         childrenIDs,
+			Depth:               types.Int64Value(depth),
+			Path:                types.StringValue(strings.Join(path, servicePathSeparator)),
+			IsUp:                types.BoolValue(service.IsUp),
+			AggregatedIsUp:      types.BoolValue(tree.aggregatedIsUp(service)),
+			CurrentIncidentType: types.StringValue(service.CurrentIncidentType),
+		})
+	})
+	state.Inconsistencies = []types.String{}
+	for _, inconsistency := range tree.inconsistencies {
+		state.Inconsistencies = append(state.Inconsistencies, types.StringValue(inconsistency))
+	}
+	state.ID = types.StringValue("placeholder") // only for test case
+
+	// Set state
+	diagnostics = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diagnostics...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// serviceTree is the tree of the services of a status page, resolved from their
+// parent IDs.
+type serviceTree struct {
+	roots           []statuspal.Service
+	children        map[int64][]statuspal.Service
+	parents         map[int64]int64
+	inconsistencies []string
+}
+
+// newServiceTree resolves the tree of the services from their parent IDs,
+// reporting the parent IDs and children IDs which do not agree. The services
+// whose parent is unknown, and the services of a parent cycle, are made
+// top-level services of the tree.
+func newServiceTree(services []statuspal.Service) *serviceTree {
+	tree := &serviceTree{
+		children: map[int64][]statuspal.Service{},
+		parents:  map[int64]int64{},
+	}
+
+	sorted := make([]statuspal.Service, len(services))
+	copy(sorted, services)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Order != sorted[j].Order {
+			return sorted[i].Order < sorted[j].Order
+		}
+		return sorted[i].ID < sorted[j].ID
+	})
+
+	byID := make(map[int64]statuspal.Service, len(sorted))
+	for _, service := range sorted {
+		byID[service.ID] = service
+	}
+
+	for _, service := range sorted {
+		if service.ParentID == nil {
+			continue
+		}
+
+		parent, ok := byID[*service.ParentID]
+		if !ok {
+			tree.inconsistencies = append(tree.inconsistencies, fmt.Sprintf(
+				"service %d (%s) has the parent %d, which does not exist",
+				service.ID, service.Name, *service.ParentID,
+			))
+			continue
+		}
+		if !slices.Contains(parent.ChildrenIDs, service.ID) {
+			tree.inconsistencies = append(tree.inconsistencies, fmt.Sprintf(
+				"service %d (%s) has the parent %d (%s), which does not list it in its children IDs",
+				service.ID, service.Name, parent.ID, parent.Name,
+			))
+		}
+		tree.parents[service.ID] = parent.ID
+	}
+
+	for _, service := range sorted {
+		for _, childID := range service.ChildrenIDs {
+			child, ok := byID[childID]
+			if !ok {
+				tree.inconsistencies = append(tree.inconsistencies, fmt.Sprintf(
+					"service %d (%s) has the child %d, which does not exist",
+					service.ID, service.Name, childID,
+				))
+				continue
+			}
+			if child.ParentID == nil || *child.ParentID != service.ID {
+				tree.inconsistencies = append(tree.inconsistencies, fmt.Sprintf(
+					"service %d (%s) has the child %d (%s), whose parent ID is not %d",
+					service.ID, service.Name, child.ID, child.Name, service.ID,
+				))
+			}
+		}
+	}
+
+	// Break the parent cycles, which no top-level service leads to
+	for _, service := range sorted {
+		visited := map[int64]bool{service.ID: true}
+		for id, ok := tree.parents[service.ID]; ok; id, ok = tree.parents[id] {
+			if visited[id] {
+				if id == service.ID {
+					tree.inconsistencies = append(tree.inconsistencies, fmt.Sprintf(
+						"service %d (%s) is its own ancestor",
+						service.ID, service.Name,
+					))
+					delete(tree.parents, service.ID)
+				}
+				break
+			}
+			visited[id] = true
+		}
+	}
+
+	for _, service := range sorted {
+		if parent, ok := tree.parents[service.ID]; ok {
+			tree.children[parent] = append(tree.children[parent], service)
+		} else {
+			tree.roots = append(tree.roots, service)
+		}
+	}
+
+	return tree
+}
+
+// walk calls fn with each service of the tree, depth-first, with its depth and
+// the names of its ancestors and its own name.
+func (t *serviceTree) walk(fn func(service statuspal.Service, depth int64, path []string)) {
+	var visit func(service statuspal.Service, path []string)
+	visit = func(service statuspal.Service, path []string) {
+		path = append(path[:len(path):len(path)], service.Name)
+		fn(service, int64(len(path)-1), path)
+		for _, child := range t.children[service.ID] {
+			visit(child, path)
+		}
+	}
+
+	for _, root := range t.roots {
+		visit(root, nil)
+	}
+}
+
+// aggregatedIsUp reports whether the service and all its descendants are up.
+func (t *serviceTree) aggregatedIsUp(service statuspal.Service) bool {
+	if !service.IsUp {
+		return false
+	}
+	for _, child := range t.children[service.ID] {
+		if !t.aggregatedIsUp(child) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *serviceTreeDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*statuspal.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf(
+				"Expected *statuspal.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	d.client = client
+}
@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccServiceTreeDataSource(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /status_pages/example-com/services", func(w http.ResponseWriter, r *http.Request) {
+		if _, err := w.Write([]byte(`{
+			"links": {"next": null, "prev": null},
+			"services": [
+				{"id": 1, "name": "Backend", "parent_id": null, "is_up": true, "children_ids": [3, 4], "order": 1},
+				{"id": 2, "name": "Frontend", "parent_id": null, "is_up": true, "children_ids": [5], "order": 2},
+				{"id": 3, "name": "API", "parent_id": 1, "is_up": true, "order": 3},
+				{"id": 4, "name": "Database", "parent_id": 1, "is_up": false, "current_incident_type": "major", "order": 4},
+				{"id": 5, "name": "API", "parent_id": 2, "is_up": true, "order": 5}
+			]
+		}`)); err != nil {
+			log.Printf(`Error writing "/status_pages/example-com/services" response: %v`, err)
+		}
+	})
+	mock := httptest.NewServer(mux)
+	defer mock.Close()
+
+	providerConfig := *providerConfig(&mock.URL)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `
+data "statuspal_service_tree" "test" {
+  status_page_subdomain = "example-com"
+  strict                = true
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.statuspal_service_tree.test", "root_ids.#", "2"),
+					resource.TestCheckResourceAttr("data.statuspal_service_tree.test", "root_ids.0", "1"),
+					resource.TestCheckResourceAttr("data.statuspal_service_tree.test", "services.#", "5"),
+					resource.TestCheckResourceAttr("data.statuspal_service_tree.test", "services.0.path", "Backend"),
+					resource.TestCheckResourceAttr("data.statuspal_service_tree.test", "services.0.depth", "0"),
+					resource.TestCheckResourceAttr("data.statuspal_service_tree.test", "services.0.is_up", "true"),
+					resource.TestCheckResourceAttr("data.statuspal_service_tree.test", "services.0.aggregated_is_up", "false"),
+					resource.TestCheckResourceAttr("data.statuspal_service_tree.test", "services.0.children_ids.#", "2"),
+					resource.TestCheckResourceAttr("data.statuspal_service_tree.test", "services.1.path", "Backend / API"),
+					resource.TestCheckResourceAttr("data.statuspal_service_tree.test", "services.1.depth", "1"),
+					resource.TestCheckResourceAttr("data.statuspal_service_tree.test", "services.1.parent_id", "1"),
+					resource.TestCheckResourceAttr("data.statuspal_service_tree.test", "services.2.path", "Backend / Database"),
+					resource.TestCheckResourceAttr("data.statuspal_service_tree.test", "services.2.current_incident_type", "major"),
+					resource.TestCheckResourceAttr("data.statuspal_service_tree.test", "services.3.path", "Frontend"),
+					resource.TestCheckResourceAttr("data.statuspal_service_tree.test", "services.3.aggregated_is_up", "true"),
+					resource.TestCheckResourceAttr("data.statuspal_service_tree.test", "services.4.path", "Frontend / API"),
+					resource.TestCheckResourceAttr("data.statuspal_service_tree.test", "inconsistencies.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccServiceTreeDataSource_inconsistent(t *testing.T) {
+	mux := http.NewServeMux()
+	// A missing parent, children_ids out of sync with the parents and a cycle
+	mux.HandleFunc("GET /status_pages/example-com/services", func(w http.ResponseWriter, r *http.Request) {
+		if _, err := w.Write([]byte(`{
+			"links": {"next": null, "prev": null},
+			"services": [
+				{"id": 1, "name": "Backend", "parent_id": null, "is_up": true, "children_ids": [2, 7], "order": 1},
+				{"id": 2, "name": "API", "parent_id": 99, "is_up": true, "order": 2},
+				{"id": 3, "name": "Database", "parent_id": 2, "is_up": true, "order": 3},
+				{"id": 4, "name": "Queue", "parent_id": 5, "is_up": true, "children_ids": [5], "order": 4},
+				{"id": 5, "name": "Worker", "parent_id": 4, "is_up": false, "children_ids": [4], "order": 5}
+			]
+		}`)); err != nil {
+			log.Printf(`Error writing "/status_pages/example-com/services" response: %v`, err)
+		}
+	})
+	mock := httptest.NewServer(mux)
+	defer mock.Close()
+
+	providerConfig := *providerConfig(&mock.URL)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Strict error testing
+			{
+				Config: providerConfig + `
+data "statuspal_service_tree" "test" {
+  status_page_subdomain = "example-com"
+  strict                = true
+}
+`,
+				ExpectError: regexp.MustCompile(`Inconsistent StatusPal Service Tree`),
+			},
+			// Read testing
+			{
+				Config: providerConfig + `
+data "statuspal_service_tree" "test" {
+  status_page_subdomain = "example-com"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.statuspal_service_tree.test", "root_ids.#", "3"),
+					resource.TestCheckResourceAttr("data.statuspal_service_tree.test", "services.#", "5"),
+					resource.TestCheckResourceAttr("data.statuspal_service_tree.test", "services.0.path", "Backend"),
+					resource.TestCheckResourceAttr("data.statuspal_service_tree.test", "services.0.children_ids.#", "0"),
+					resource.TestCheckResourceAttr("data.statuspal_service_tree.test", "services.1.path", "API"),
+					resource.TestCheckResourceAttr("data.statuspal_service_tree.test", "services.1.parent_id", ""),
+					resource.TestCheckResourceAttr("data.statuspal_service_tree.test", "services.2.path", "API / Database"),
+					resource.TestCheckResourceAttr("data.statuspal_service_tree.test", "services.3.path", "Queue"),
+					resource.TestCheckResourceAttr("data.statuspal_service_tree.test", "services.3.aggregated_is_up", "false"),
+					resource.TestCheckResourceAttr("data.statuspal_service_tree.test", "services.4.path", "Queue / Worker"),
+					resource.TestCheckResourceAttr("data.statuspal_service_tree.test", "inconsistencies.#", "5"),
+					resource.TestCheckResourceAttr("data.statuspal_service_tree.test", "inconsistencies.0", "service 2 (API) has the parent 99, which does not exist"),
+					resource.TestCheckResourceAttr("data.statuspal_service_tree.test", "inconsistencies.4", "service 4 (Queue) is its own ancestor"),
+				),
+			},
+		},
+	})
+}
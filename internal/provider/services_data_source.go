@@ -3,12 +3,19 @@ package provider
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 
 	statuspal "terraform-provider-statuspal/internal/client"
 )
@@ -33,9 +40,20 @@ type servicesDataSource struct {
 type servicesDataSourceModel struct {
 	ID                  types.String    `tfsdk:"id"` // only for test case
 	StatusPageSubdomain types.String    `tfsdk:"status_page_subdomain"`
+	Limit               types.Int64     `tfsdk:"limit"`
+	Filter              types.Object    `tfsdk:"filter"`
 	Services            []servicesModel `tfsdk:"services"`
 }
 
+// servicesFilterModel maps the filter block of the services data source.
+type servicesFilterModel struct {
+	Monitoring types.String `tfsdk:"monitoring"`
+	IsUp       types.Bool   `tfsdk:"is_up"`
+	Private    types.Bool   `tfsdk:"private"`
+	ParentID   types.String `tfsdk:"parent_id"`
+	NameRegex  types.String `tfsdk:"name_regex"`
+}
+
 // servicesModel maps services schema data.
 type servicesModel struct {
 	ID                                types.String `tfsdk:"id"`
@@ -81,6 +99,36 @@ func (d *servicesDataSource) Metadata(
 func (d *servicesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Fetches the list of services in the status page.",
+		Blocks: map[string]schema.Block{
+			"filter": schema.SingleNestedBlock{
+				Description: "Only return the services matching all the given conditions.",
+				Attributes: map[string]schema.Attribute{
+					"monitoring": schema.StringAttribute{
+						MarkdownDescription: "Only return the services with this monitoring type: `\"\"` (no monitoring), `internal`, `3rd_party` or `webhook`.",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("", "internal", "3rd_party", "webhook"),
+						},
+					},
+					"is_up": schema.BoolAttribute{
+						Description: "Only return the services which are up (true) or down (false).",
+						Optional:    true,
+					},
+					"private": schema.BoolAttribute{
+						Description: "Only return the private (true) or public (false) services.",
+						Optional:    true,
+					},
+					"parent_id": schema.StringAttribute{
+						MarkdownDescription: "Only return the children of the service with this ID. Set it to `\"\"` to only return the top-level services.",
+						Optional:            true,
+					},
+					"name_regex": schema.StringAttribute{
+						Description: "Only return the services with a name matching this regular expression.",
+						Optional:    true,
+					},
+				},
+			},
+		},
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "Placeholder identifier attribute. Ignore it, only used in testing.",
@@ -90,155 +138,174 @@ func (d *servicesDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 				Description: "The status page subdomain of the services.",
 				Required:    true,
 			},
+			"limit": schema.Int64Attribute{
+				Description: "The maximum number of services to return, after filtering. By default, all the matching services of the status page are returned.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
 			"services": schema.ListNestedAttribute{
 				Description: "List of services.",
 				Computed:    true,
 				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"id": schema.StringAttribute{
-							Description: "The ID of the service.",
-							Computed:    true,
-						},
-						"name": schema.StringAttribute{
-							Description: "The name of the service.",
-							Computed:    true,
-						},
-						"description": schema.StringAttribute{
-							Description: "The description of the service.",
-							Computed:    true,
-						},
-						"private_description": schema.StringAttribute{
-							Description: "The private description of the service.",
-							Computed:    true,
-						},
-						"parent_id": schema.StringAttribute{
-							Description: "The service parent ID.",
-							Computed:    true,
-						},
-						"current_incident_type": schema.StringAttribute{
-							MarkdownDescription: "Enum: `\"major\"` `\"minor\"` `\"scheduled\"`\n  The service's current incident type.\n  The type of the (current) incident:\n" +
-								"  - `minor` - A minor incident is currently taking place.\n" +
-								"  - `major` - A major incident is currently taking place.\n" +
-								"  - `scheduled` - A scheduled maintenance is currently taking place.",
-							Computed: true,
-						},
-						"monitoring": schema.StringAttribute{
-							MarkdownDescription: "Enum: `\"\"` `\"internal\"` `\"3rd_party\"` `\"webhook\"`\n  Monitoring types:\n" +
-								"  - `\"\"` - No monitoring.\n" +
-								"  - `internal` - StatusPal monitoring.\n" +
-								"  - `3rd_party` - 3rd Party monitoring.\n" +
-								"  - `webhook` - Incoming webhook monitoring.",
-							Computed: true,
-						},
-						"webhook_monitoring_service": schema.StringAttribute{
-							MarkdownDescription: "Enum: `\"status-cake\"` `\"uptime-robot\"` `\"custom-jsonpath\"`\n" +
-								"  **Configure this field only if the `monitoring` is set to `webhook`.**\n" +
-								"  Webhook Monitoring types:\n" +
-								"  - `status-cake` - StatusCake monitoring service.\n" +
-								"  - `uptime-robot` - UptimeRobot monitoring service.\n" +
-								"  - `3rd_party` - Custom JSONPath.",
-							Computed: true,
-						},
-						"webhook_custom_jsonpath_settings": schema.SingleNestedAttribute{
-							MarkdownDescription: "The webhook monitoring service custom JSONPath settings.\n" +
-								"  **Configure this field only if the `webhook_monitoring_service` is set to `custom-jsonpath`.**\n→ ",
-							Computed: true,
-							Attributes: map[string]schema.Attribute{
-								"jsonpath": schema.StringAttribute{
-									MarkdownDescription: "The path in the JSON, e.g. `$.status`",
-									Computed:            true,
-								},
-								"expected_result": schema.StringAttribute{
-									MarkdownDescription: "The expected result in the JSON, e.g. `\"up\"`",
-									Computed:            true,
-								},
+					Attributes: serviceDataSourceAttributes(),
+				},
+			},
+		},
+	}
+}
+
+// serviceDataSourceAttributes returns the attributes of a service in the data sources.
+func serviceDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "The ID of the service.",
+			Computed:    true,
+		},
+		"name": schema.StringAttribute{
+			Description: "The name of the service.",
+			Computed:    true,
+		},
+		"description": schema.StringAttribute{
+			Description: "The description of the service.",
+			Computed:    true,
+		},
+		"private_description": schema.StringAttribute{
+			Description: "The private description of the service.",
+			Computed:    true,
+		},
+		"parent_id": schema.StringAttribute{
+			Description: "The service parent ID.",
+			Computed:    true,
+		},
+		"current_incident_type": schema.StringAttribute{
+			MarkdownDescription: "The service's current incident type.\n  The type of the (current) incident:\n" +
+				"  - `minor` - A minor incident is currently taking place.\n" +
+				"  - `major` - A major incident is currently taking place.\n" +
+				"  - `scheduled` - A scheduled maintenance is currently taking place.\n" +
+				"  - the ID of a custom incident type - When the `custom_incident_types_enabled` attribute of the status page is enabled.",
+			Computed: true,
+		},
+		"monitoring": schema.StringAttribute{
+			MarkdownDescription: "Enum: `\"\"` `\"internal\"` `\"3rd_party\"` `\"webhook\"`\n  Monitoring types:\n" +
+				"  - `\"\"` - No monitoring.\n" +
+				"  - `internal` - StatusPal monitoring.\n" +
+				"  - `3rd_party` - 3rd Party monitoring.\n" +
+				"  - `webhook` - Incoming webhook monitoring.",
+			Computed: true,
+		},
+		"webhook_monitoring_service": schema.StringAttribute{
+			MarkdownDescription: "Enum: `\"status-cake\"` `\"uptime-robot\"` `\"custom-jsonpath\"`\n" +
+				"  **Configure this field only if the `monitoring` is set to `webhook`.**\n" +
+				"  Webhook Monitoring types:\n" +
+				"  - `status-cake` - StatusCake monitoring service.\n" +
+				"  - `uptime-robot` - UptimeRobot monitoring service.\n" +
+				"  - `3rd_party` - Custom JSONPath.",
+			Computed: true,
+		},
+		"webhook_custom_jsonpath_settings": schema.SingleNestedAttribute{
+			MarkdownDescription: "The webhook monitoring service custom JSONPath settings.\n" +
+				"  **Configure this field only if the `webhook_monitoring_service` is set to `custom-jsonpath`.**\n→ ",
+			Computed: true,
+			Attributes: map[string]schema.Attribute{
+				"jsonpath": schema.StringAttribute{
+					MarkdownDescription: "The path in the JSON, e.g. `$.status`",
+					Computed:            true,
+				},
+				"expected_result": schema.StringAttribute{
+					MarkdownDescription: "The expected result in the JSON, e.g. `\"up\"`",
+					Computed:            true,
+				},
+			},
+		},
+		"monitoring_options": schema.SingleNestedAttribute{
+			MarkdownDescription: "Configuration options for monitoring the service. These options vary depending on whether the monitoring type is internal or third-party.",
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"method": schema.StringAttribute{
+					MarkdownDescription: "The HTTP method used for monitoring requests. Example: `HEAD`.",
+					Computed:            true,
+				},
+				"headers": schema.ListNestedAttribute{
+					MarkdownDescription: "A list of header objects to be sent with the monitoring request. Each header should include a `key` and `value`.",
+					Computed:            true,
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"key": schema.StringAttribute{
+								MarkdownDescription: "The key of the header. Example: `Authorization`.",
+								Computed:            true,
 							},
-						},
-						"monitoring_options": schema.SingleNestedAttribute{
-							MarkdownDescription: "Configuration options for monitoring the service. These options vary depending on whether the monitoring type is internal or third-party.",
-							Computed:            true,
-							Attributes: map[string]schema.Attribute{
-								"method": schema.StringAttribute{
-									MarkdownDescription: "The HTTP method used for monitoring requests. Example: `HEAD`.",
-									Computed:            true,
-								},
-								"headers": schema.ListNestedAttribute{
-									MarkdownDescription: "A list of header objects to be sent with the monitoring request. Each header should include a `key` and `value`.",
-									Computed:            true,
-									NestedObject: schema.NestedAttributeObject{
-										Attributes: map[string]schema.Attribute{
-											"key": schema.StringAttribute{
-												MarkdownDescription: "The key of the header. Example: `Authorization`.",
-												Computed:            true,
-											},
-											"value": schema.StringAttribute{
-												MarkdownDescription: "The value of the header. Example: `Bearer token`.",
-												Computed:            true,
-											},
-										},
-									},
-								},
-								"keyword_up": schema.StringAttribute{
-									MarkdownDescription: "A custom keyword that indicates a 'up' status when monitoring a third-party service.This keyword is used to parse and understand service",
-									Computed:            true,
-								},
-								"keyword_down": schema.StringAttribute{
-									MarkdownDescription: "A custom keyword that indicates a 'down' status when monitoring a third-party service. This keyword is used to parse and understand service.",
-									Computed:            true,
-								},
+							"value": schema.StringAttribute{
+								MarkdownDescription: "The value of the header. Example: `Bearer token`.",
+								Computed:            true,
 							},
 						},
-						"inbound_email_address": schema.StringAttribute{
-							MarkdownDescription: "This is field is populated from `inbound_email_id`, if the `monitoring` is set to `3rd_party`.",
-							Computed:            true,
-						},
-						"incoming_webhook_url": schema.StringAttribute{
-							MarkdownDescription: "This is field is populated from `inbound_email_id`, if the `monitoring` is set to `webhook` and the `webhook_monitoring_service` is set.",
-							Computed:            true,
-						},
-						"ping_url": schema.StringAttribute{
-							Description: "We will send HTTP requests to this URL for monitoring every minute.",
-							Computed:    true,
-						},
-						"incident_type": schema.StringAttribute{
-							MarkdownDescription: "Enum: `\"major\"` `\"minor\"`\n  Sets the incident type to this value when an incident is created via monitoring.\n  The type of the (current) incident:\n" +
-								"  - `minor` - A minor incident is currently taking place.\n" +
-								"  - `major` - A major incident is currently taking place.",
-							Computed: true,
-						},
-						"parent_incident_type": schema.StringAttribute{
-							MarkdownDescription: "Enum: `\"major\"` `\"minor\"`\n  Sets the parent's service incident type to this value when an incident is created via monitoring.\n  The type of the (current) incident:\n" +
-								"  - `minor` - A minor incident is currently taking place.\n" +
-								"  - `major` - A major incident is currently taking place.",
-							Computed: true,
-						},
-						"is_up": schema.BoolAttribute{
-							Description: "Is the monitored service up?",
-							Computed:    true,
-						},
-						"pause_monitoring_during_maintenances": schema.BoolAttribute{
-							Description: "Pause the the service monitoring during maintenances?",
-							Computed:    true,
-						},
-						"inbound_email_id": schema.StringAttribute{
-							Description: "The inbound email ID.",
-							Computed:    true,
-						},
-						"auto_incident": schema.BoolAttribute{
-							Description: "Create an incident automatically when this service is down and close it if/when it comes back up.",
-							Computed:    true,
-						},
-						"auto_notify": schema.BoolAttribute{
-							Description: "Automatically notify all your subscribers about automatically created and closed incidents.",
-							Computed:    true,
-						},
-						"children_ids": schema.ListAttribute{
-							Description: "IDs of the service's children.",
-							Computed:    true,
-							ElementType: types.Int64Type,
-						},
-						"translations": schema.MapNestedAttribute{
-							MarkdownDescription: "A translations object. For example:\n  ```terraform" + `
+					},
+				},
+				"keyword_up": schema.StringAttribute{
+					MarkdownDescription: "A custom keyword that indicates a 'up' status when monitoring a third-party service.This keyword is used to parse and understand service",
+					Computed:            true,
+				},
+				"keyword_down": schema.StringAttribute{
+					MarkdownDescription: "A custom keyword that indicates a 'down' status when monitoring a third-party service. This keyword is used to parse and understand service.",
+					Computed:            true,
+				},
+			},
+		},
+		"inbound_email_address": schema.StringAttribute{
+			MarkdownDescription: "This is field is populated from `inbound_email_id`, if the `monitoring` is set to `3rd_party`.",
+			Computed:            true,
+		},
+		"incoming_webhook_url": schema.StringAttribute{
+			MarkdownDescription: "This is field is populated from `inbound_email_id`, if the `monitoring` is set to `webhook` and the `webhook_monitoring_service` is set.",
+			Computed:            true,
+		},
+		"ping_url": schema.StringAttribute{
+			Description: "We will send HTTP requests to this URL for monitoring every minute.",
+			Computed:    true,
+		},
+		"incident_type": schema.StringAttribute{
+			MarkdownDescription: "Sets the incident type to this value when an incident is created via monitoring.\n  The type of the (current) incident:\n" +
+				"  - `minor` - A minor incident is currently taking place.\n" +
+				"  - `major` - A major incident is currently taking place.\n" +
+				"  - the ID of a custom incident type - When the `custom_incident_types_enabled` attribute of the status page is enabled.",
+			Computed: true,
+		},
+		"parent_incident_type": schema.StringAttribute{
+			MarkdownDescription: "Sets the parent's service incident type to this value when an incident is created via monitoring.\n  The type of the (current) incident:\n" +
+				"  - `minor` - A minor incident is currently taking place.\n" +
+				"  - `major` - A major incident is currently taking place.\n" +
+				"  - the ID of a custom incident type - When the `custom_incident_types_enabled` attribute of the status page is enabled.",
+			Computed: true,
+		},
+		"is_up": schema.BoolAttribute{
+			Description: "Is the monitored service up?",
+			Computed:    true,
+		},
+		"pause_monitoring_during_maintenances": schema.BoolAttribute{
+			Description: "Pause the the service monitoring during maintenances?",
+			Computed:    true,
+		},
+		"inbound_email_id": schema.StringAttribute{
+			Description: "The inbound email ID.",
+			Computed:    true,
+		},
+		"auto_incident": schema.BoolAttribute{
+			Description: "Create an incident automatically when this service is down and close it if/when it comes back up.",
+			Computed:    true,
+		},
+		"auto_notify": schema.BoolAttribute{
+			Description: "Automatically notify all your subscribers about automatically created and closed incidents.",
+			Computed:    true,
+		},
+		"children_ids": schema.ListAttribute{
+			Description: "IDs of the service's children.",
+			Computed:    true,
+			ElementType: types.Int64Type,
+		},
+		"translations": schema.MapNestedAttribute{
+			MarkdownDescription: "A translations object. For example:\n  ```terraform" + `
 	{
 		en = {
 			name = "Your service"
@@ -250,48 +317,44 @@ func (d *servicesDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 		}
 	}
 ` + "  ```\n→ ",
-							Computed: true,
-							NestedObject: schema.NestedAttributeObject{
-								Attributes: map[string]schema.Attribute{
-									"name": schema.StringAttribute{
-										Description: "The name of the service.",
-										Computed:    true,
-									},
-									"description": schema.StringAttribute{
-										Description: "The description of the service.",
-										Computed:    true,
-									},
-								},
-							},
-						},
-						"private": schema.BoolAttribute{
-							Description: "Private service?",
-							Computed:    true,
-						},
-						"display_uptime_graph": schema.BoolAttribute{
-							Description: "Display uptime graph?",
-							Computed:    true,
-						},
-						"display_response_time_chart": schema.BoolAttribute{
-							Description: "Display response time chart?",
-							Computed:    true,
-						},
-						"order": schema.Int64Attribute{
-							Description: "Service's position in the service list.",
-							Computed:    true,
-						},
-						"inserted_at": schema.StringAttribute{
-							Description: "Datetime at which the service was inserted.",
-							Computed:    true,
-						},
-						"updated_at": schema.StringAttribute{
-							Description: "Datetime at which the service was last updated.",
-							Computed:    true,
-						},
+			Computed: true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Description: "The name of the service.",
+						Computed:    true,
+					},
+					"description": schema.StringAttribute{
+						Description: "The description of the service.",
+						Computed:    true,
 					},
 				},
 			},
 		},
+		"private": schema.BoolAttribute{
+			Description: "Private service?",
+			Computed:    true,
+		},
+		"display_uptime_graph": schema.BoolAttribute{
+			Description: "Display uptime graph?",
+			Computed:    true,
+		},
+		"display_response_time_chart": schema.BoolAttribute{
+			Description: "Display response time chart?",
+			Computed:    true,
+		},
+		"order": schema.Int64Attribute{
+			Description: "Service's position in the service list.",
+			Computed:    true,
+		},
+		"inserted_at": schema.StringAttribute{
+			Description: "Datetime at which the service was inserted.",
+			Computed:    true,
+		},
+		"updated_at": schema.StringAttribute{
+			Description: "Datetime at which the service was last updated.",
+			Computed:    true,
+		},
 	}
 }
 
@@ -305,8 +368,31 @@ func (d *servicesDataSource) Read(ctx context.Context, req datasource.ReadReques
 		return
 	}
 
+	// The filters are sent as query parameters of the StatusPal API, except the
+	// name regex which is matched on each page as they are received.
+	var filter servicesFilterModel
+	if !state.Filter.IsNull() {
+		resp.Diagnostics.Append(state.Filter.As(ctx, &filter, basetypes.ObjectAsOptions{})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	nameRegex := compileRegex(filter.NameRegex, path.Root("filter").AtName("name_regex"), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	statusPageSubdomain := state.StatusPageSubdomain.ValueString()
-	services, err := d.client.GetServices(&statusPageSubdomain)
+	limit := state.Limit.ValueInt64()
+	services := []statuspal.Service{}
+	err := d.client.ListServices(ctx, &statusPageSubdomain, filter.query(), func(page []statuspal.Service) bool {
+		for _, service := range page {
+			if nameRegex == nil || nameRegex.MatchString(service.Name) {
+				services = append(services, service)
+			}
+		}
+		return limit == 0 || int64(len(services)) < limit
+	})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read StatusPal Services",
@@ -315,8 +401,12 @@ func (d *servicesDataSource) Read(ctx context.Context, req datasource.ReadReques
 		return
 	}
 
+	if limit > 0 && int64(len(services)) > limit {
+		services = services[:limit]
+	}
+
 	// Map response body to model
-	for _, service := range *services {
+	for _, service := range services {
 		// Define the translation object schema
 		translationSchema := map[string]attr.Type{
 			"name":        types.StringType,
@@ -474,6 +564,36 @@ func (d *servicesDataSource) Read(ctx context.Context, req datasource.ReadReques
 	}
 }
 
+// query returns the API query parameters of the filter.
+func (f servicesFilterModel) query() statuspal.ServicesQuery {
+	return statuspal.ServicesQuery{
+		Monitoring: f.Monitoring.ValueStringPointer(),
+		IsUp:       f.IsUp.ValueBoolPointer(),
+		Private:    f.Private.ValueBoolPointer(),
+		ParentID:   f.ParentID.ValueStringPointer(),
+	}
+}
+
+// compileRegex compiles the regular expression of the attribute, adding an
+// attribute error if it is invalid. It returns nil if the attribute is not set.
+func compileRegex(value types.String, attribute path.Path, diagnostics *diag.Diagnostics) *regexp.Regexp {
+	if value.IsNull() {
+		return nil
+	}
+
+	re, err := regexp.Compile(value.ValueString())
+	if err != nil {
+		diagnostics.AddAttributeError(
+			attribute,
+			"Invalid Regular Expression",
+			fmt.Sprintf("The value is not a valid regular expression: %s", err),
+		)
+		return nil
+	}
+
+	return re
+}
+
 // Configure adds the provider configured client to the data source.
 func (d *servicesDataSource) Configure(
 	_ context.Context,
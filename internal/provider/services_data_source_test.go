@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"fmt"
 	"log"
 	"net/http"
 	"net/http/httptest"
@@ -253,3 +254,164 @@ func TestAccServicesDataSource(t *testing.T) {
 		},
 	})
 }
+
+func TestAccServicesDataSource_pagination(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/status_pages/terraform-test/services", func(w http.ResponseWriter, r *http.Request) {
+		// Mock paginated responses for data source
+		body := `{
+			"links": {"next": "/status_pages/terraform-test/services?after=2", "prev": null},
+			"services": [{"id": 1, "name": "web"}, {"id": 2, "name": "api"}]
+		}`
+		if r.URL.Query().Get("after") == "2" {
+			body = `{
+				"links": {"next": null, "prev": "/status_pages/terraform-test/services?before=3"},
+				"services": [{"id": 3, "name": "database"}]
+			}`
+		}
+
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write([]byte(body)); err != nil {
+			log.Printf(`Error writing "/status_pages/terraform-test/services" response: %v`, err)
+			return
+		}
+	})
+	mockServer := httptest.NewServer(mux)
+	defer mockServer.Close()
+	providerConfig := providerConfig(&mockServer.URL)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read all the pages testing
+			{
+				Config: *providerConfig + `data "statuspal_services" "test" {
+					status_page_subdomain = "terraform-test"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.statuspal_services.test", "services.#", "3"),
+					resource.TestCheckResourceAttr("data.statuspal_services.test", "services.2.name", "database"),
+				),
+			},
+			// Limit testing
+			{
+				Config: *providerConfig + `data "statuspal_services" "test" {
+					status_page_subdomain = "terraform-test"
+					limit                 = 2
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.statuspal_services.test", "limit", "2"),
+					resource.TestCheckResourceAttr("data.statuspal_services.test", "services.#", "2"),
+					resource.TestCheckResourceAttr("data.statuspal_services.test", "services.1.name", "api"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccServicesDataSource_filter(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/status_pages/example-com/services", func(w http.ResponseWriter, r *http.Request) {
+		// The API filters the services with the query parameters, the name regex is matched by the provider
+		var body string
+		switch r.URL.RawQuery {
+		case "":
+			body = `[
+				{"id": 1, "name": "Backend", "parent_id": null, "is_up": true, "children_ids": [3, 4], "order": 1},
+				{"id": 2, "name": "Frontend", "parent_id": null, "is_up": true, "children_ids": [5], "order": 2},
+				{"id": 3, "name": "API", "parent_id": 1, "is_up": true, "monitoring": "internal", "order": 3},
+				{"id": 4, "name": "Database", "parent_id": 1, "is_up": false, "current_incident_type": "major", "private": true, "order": 4},
+				{"id": 5, "name": "API", "parent_id": 2, "is_up": true, "order": 5}
+			]`
+		case "is_up=false":
+			body = `[
+				{"id": 4, "name": "Database", "parent_id": 1, "is_up": false, "current_incident_type": "major", "private": true, "order": 4}
+			]`
+		case "parent_id=":
+			body = `[
+				{"id": 1, "name": "Backend", "parent_id": null, "is_up": true, "children_ids": [3, 4], "order": 1},
+				{"id": 2, "name": "Frontend", "parent_id": null, "is_up": true, "children_ids": [5], "order": 2}
+			]`
+		case "monitoring=internal&parent_id=1&private=false":
+			body = `[
+				{"id": 3, "name": "API", "parent_id": 1, "is_up": true, "monitoring": "internal", "order": 3}
+			]`
+		default:
+			http.Error(w, fmt.Sprintf("Unexpected query %q", r.URL.RawQuery), http.StatusBadRequest)
+			return
+		}
+
+		if _, err := w.Write([]byte(`{"links": {"next": null, "prev": null}, "services": ` + body + `}`)); err != nil {
+			log.Printf(`Error writing "/status_pages/example-com/services" response: %v`, err)
+			return
+		}
+	})
+	mockServer := httptest.NewServer(mux)
+	defer mockServer.Close()
+	providerConfig := providerConfig(&mockServer.URL)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Invalid regular expression error testing
+			{
+				Config: *providerConfig + `data "statuspal_services" "test" {
+					status_page_subdomain = "example-com"
+
+					filter {
+						name_regex = "(API"
+					}
+				}`,
+				ExpectError: regexp.MustCompile(`Invalid Regular Expression`),
+			},
+			// Filter testing
+			{
+				Config: *providerConfig + `data "statuspal_services" "down" {
+					status_page_subdomain = "example-com"
+
+					filter {
+						is_up = false
+					}
+				}
+
+				data "statuspal_services" "top_level" {
+					status_page_subdomain = "example-com"
+
+					filter {
+						parent_id = ""
+					}
+				}
+
+				data "statuspal_services" "backend_api" {
+					status_page_subdomain = "example-com"
+
+					filter {
+						parent_id  = "1"
+						name_regex = "(?i)^api$"
+						private    = false
+						monitoring = "internal"
+					}
+				}
+
+				data "statuspal_services" "limited" {
+					status_page_subdomain = "example-com"
+					limit                 = 1
+
+					filter {
+						name_regex = "API"
+					}
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.statuspal_services.down", "services.#", "1"),
+					resource.TestCheckResourceAttr("data.statuspal_services.down", "services.0.name", "Database"),
+					resource.TestCheckResourceAttr("data.statuspal_services.top_level", "services.#", "2"),
+					resource.TestCheckResourceAttr("data.statuspal_services.top_level", "services.1.name", "Frontend"),
+					resource.TestCheckResourceAttr("data.statuspal_services.backend_api", "services.#", "1"),
+					resource.TestCheckResourceAttr("data.statuspal_services.backend_api", "services.0.id", "3"),
+					resource.TestCheckResourceAttr("data.statuspal_services.limited", "services.#", "1"),
+					resource.TestCheckResourceAttr("data.statuspal_services.limited", "services.0.id", "3"),
+				),
+			},
+		},
+	})
+}
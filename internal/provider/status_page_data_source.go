@@ -0,0 +1,280 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	statuspal "terraform-provider-statuspal/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &statusPageDataSource{}
+	_ datasource.DataSourceWithConfigure = &statusPageDataSource{}
+)
+
+// NewStatusPageDataSource is a helper function to simplify the provider implementation.
+func NewStatusPageDataSource() datasource.DataSource {
+	return &statusPageDataSource{}
+}
+
+// statusPageDataSource is the data source implementation.
+type statusPageDataSource struct {
+	client *statuspal.Client
+}
+
+// statusPageDataSourceModel maps the data source schema data.
+type statusPageDataSourceModel struct {
+	ID             types.String     `tfsdk:"id"` // only for test case
+	OrganizationID types.String     `tfsdk:"organization_id"`
+	Subdomain      types.String     `tfsdk:"subdomain"`
+	Name           types.String     `tfsdk:"name"`
+	NameRegex      types.String     `tfsdk:"name_regex"`
+	StatusPage     *statusPageModel `tfsdk:"status_page"`
+}
+
+// Metadata returns the data source type name.
+func (d *statusPageDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_status_page"
+}
+
+// Schema defines the schema for the data source.
+func (d *statusPageDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := statusPageDataSourceAttributes()
+	attributes["domain_config"] = schema.SingleNestedAttribute{
+		Description: "Custom domain configuration for the status page.",
+		Computed:    true,
+		Attributes: map[string]schema.Attribute{
+			"provider": schema.StringAttribute{
+				Description: `Custom domain provider, either "cloudflare" or "bunny".`,
+				Computed:    true,
+			},
+			"domain": schema.StringAttribute{
+				Description: `The custom hostname (e.g. "status.acme.com").`,
+				Computed:    true,
+			},
+			"main_hostname": schema.StringAttribute{
+				Description: "The CNAME target to point your domain at.",
+				Computed:    true,
+			},
+			"validation_records": schema.MapNestedAttribute{
+				Description: `DNS records required for domain setup. Keys: "cname" (CNAME routing record), "hostname_txt" (TXT record for hostname verification), "txt" (TXT record for ACME SSL challenge — only present after CNAME is in DNS). Not all keys are present at every lifecycle stage.`,
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "DNS record name (hostname).",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: `DNS record type (e.g. "CNAME", "TXT").`,
+							Computed:    true,
+						},
+						"value": schema.StringAttribute{
+							Description: "DNS record value.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"external_id": schema.StringAttribute{
+				Description: "Upstream provider identifier, useful for debugging.",
+				Computed:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: `Current verification state: "disabled", "configuring", "active", or "failed_to_configure".`,
+				Computed:    true,
+			},
+			"error": schema.StringAttribute{
+				Description: `Error details when status is "failed_to_configure".`,
+				Computed:    true,
+			},
+			"pullzone_id": schema.Int64Attribute{
+				Description: "Bunny-specific pullzone ID.",
+				Computed:    true,
+			},
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Fetches a single status page of the organization, looked up by its subdomain or by its name.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier attribute. Ignore it, only used in testing.",
+				Computed:    true,
+			},
+			"organization_id": schema.StringAttribute{
+				Description: "The organization ID of the status page.",
+				Required:    true,
+			},
+			"subdomain": schema.StringAttribute{
+				Description: "The subdomain of the status page to look up. Exactly one of subdomain, name or name_regex must be set.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(
+						path.MatchRoot("subdomain"),
+						path.MatchRoot("name"),
+						path.MatchRoot("name_regex"),
+					),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The exact name of the status page to look up. It must match a single status page of the organization.",
+				Optional:    true,
+			},
+			"name_regex": schema.StringAttribute{
+				Description: "A regular expression matched against the status page names. It must match a single status page of the organization.",
+				Optional:    true,
+			},
+			"status_page": schema.SingleNestedAttribute{
+				Description: "The status page found.",
+				Computed:    true,
+				Attributes:  attributes,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *statusPageDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Retrieve values from config
+	var state statusPageDataSourceModel
+	diagnostics := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diagnostics...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organizationID := state.OrganizationID.ValueString()
+	var statusPage *statuspal.StatusPage
+	if !state.Subdomain.IsNull() {
+		subdomain := state.Subdomain.ValueString()
+		found, err := d.client.GetStatusPage(ctx, &organizationID, &subdomain)
+		if statuspal.ErrorNotFound(err) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("subdomain"),
+				"StatusPal Status Page Not Found",
+				fmt.Sprintf("No status page with the subdomain %q was found in the organization %q.", subdomain, organizationID),
+			)
+			return
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read StatusPal Status Page",
+				err.Error(),
+			)
+			return
+		}
+		statusPage = found
+	} else {
+		attribute := path.Root("name")
+		match := func(name string) bool { return name == state.Name.ValueString() }
+		description := fmt.Sprintf("the name %q", state.Name.ValueString())
+		if !state.NameRegex.IsNull() {
+			attribute = path.Root("name_regex")
+			re := compileRegex(state.NameRegex, attribute, &resp.Diagnostics)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			match = re.MatchString
+			description = fmt.Sprintf("a name matching %q", state.NameRegex.ValueString())
+		}
+
+		matches := []statuspal.StatusPage{}
+		err := d.client.ListStatusPages(ctx, &organizationID, func(page []statuspal.StatusPage) bool {
+			for _, statusPage := range page {
+				if match(statusPage.Name) {
+					matches = append(matches, statusPage)
+				}
+			}
+			return true
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read StatusPal Status Page",
+				err.Error(),
+			)
+			return
+		}
+
+		switch len(matches) {
+		case 0:
+			resp.Diagnostics.AddAttributeError(
+				attribute,
+				"StatusPal Status Page Not Found",
+				fmt.Sprintf("No status page with %s was found in the organization %q.", description, organizationID),
+			)
+			return
+		case 1:
+			statusPage = &matches[0]
+		default:
+			subdomains := make([]string, 0, len(matches))
+			for _, match := range matches {
+				subdomains = append(subdomains, match.Subdomain)
+			}
+			resp.Diagnostics.AddAttributeError(
+				attribute,
+				"Multiple StatusPal Status Pages Found",
+				fmt.Sprintf(
+					"%d status pages with %s were found in the organization %q (subdomains: %s). Use a more specific name or look the status page up by its subdomain.",
+					len(matches), description, organizationID, strings.Join(subdomains, ", "),
+				),
+			)
+			return
+		}
+	}
+
+	// Map response body to model
+	state.StatusPage = mapResponseToStatusPageModel(statusPage, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.ID = types.StringValue("placeholder") // only for test case
+
+	// Set state
+	diagnostics = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diagnostics...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *statusPageDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*statuspal.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf(
+				"Expected *statuspal.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	d.client = client
+}
@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccStatusPageDataSource(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /orgs/1/status_pages", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write([]byte(`{
+			"links": {"next": null, "prev": null},
+			"status_pages": [
+				{"name": "Acme", "subdomain": "acme", "url": "acme.com", "time_zone": "Europe/Berlin"},
+				{"name": "Acme Staging", "subdomain": "acme-staging", "url": "staging.acme.com", "time_zone": "UTC"},
+				{
+					"name": "Example",
+					"subdomain": "example-com",
+					"url": "example.com",
+					"time_zone": "UTC",
+					"translations": {"fr": {"public_company_name": "Exemple", "header_logo_text": ""}},
+					"domain_config": {
+						"provider": "cloudflare",
+						"domain": "status.example.com",
+						"main_hostname": "example-com.statuspal.io",
+						"status": "active"
+					}
+				}
+			]
+		}`)); err != nil {
+			log.Fatalf("Failed to write response: %v", err)
+		}
+	})
+	mux.HandleFunc("GET /orgs/1/status_pages/example-com", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write([]byte(`{
+			"status_page": {
+				"name": "Example",
+				"subdomain": "example-com",
+				"url": "example.com",
+				"time_zone": "UTC",
+				"translations": {"fr": {"public_company_name": "Exemple", "header_logo_text": ""}},
+				"domain_config": {
+					"provider": "cloudflare",
+					"domain": "status.example.com",
+					"main_hostname": "example-com.statuspal.io",
+					"status": "active"
+				}
+			}
+		}`)); err != nil {
+			log.Fatalf("Failed to write response: %v", err)
+		}
+	})
+	mux.HandleFunc("GET /orgs/1/status_pages/{subdomain}", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+	mock := httptest.NewServer(mux)
+	defer mock.Close()
+
+	providerConfig := *providerConfig(&mock.URL)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Missing lookup attribute error testing
+			{
+				Config: providerConfig + `
+data "statuspal_status_page" "test" {
+  organization_id = "1"
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			// Not found error testing
+			{
+				Config: providerConfig + `
+data "statuspal_status_page" "test" {
+  organization_id = "1"
+  subdomain       = "unknown"
+}
+`,
+				ExpectError: regexp.MustCompile(`StatusPal Status Page Not Found`),
+			},
+			{
+				Config: providerConfig + `
+data "statuspal_status_page" "test" {
+  organization_id = "1"
+  name            = "Unknown"
+}
+`,
+				ExpectError: regexp.MustCompile(`StatusPal Status Page Not Found`),
+			},
+			// Multiple matches error testing
+			{
+				Config: providerConfig + `
+data "statuspal_status_page" "test" {
+  organization_id = "1"
+  name_regex      = "^Acme"
+}
+`,
+				ExpectError: regexp.MustCompile(`Multiple StatusPal Status Pages Found`),
+			},
+			// Read testing
+			{
+				Config: providerConfig + `
+data "statuspal_status_page" "by_subdomain" {
+  organization_id = "1"
+  subdomain       = "example-com"
+}
+
+data "statuspal_status_page" "by_name" {
+  organization_id = "1"
+  name            = "Acme"
+}
+
+data "statuspal_status_page" "by_name_regex" {
+  organization_id = "1"
+  name_regex      = "(?i)staging"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.statuspal_status_page.by_subdomain", "status_page.name", "Example"),
+					resource.TestCheckResourceAttr("data.statuspal_status_page.by_subdomain", "status_page.translations.fr.public_company_name", "Exemple"),
+					resource.TestCheckResourceAttr("data.statuspal_status_page.by_subdomain", "status_page.domain_config.provider", "cloudflare"),
+					resource.TestCheckResourceAttr("data.statuspal_status_page.by_subdomain", "status_page.domain_config.domain", "status.example.com"),
+					resource.TestCheckResourceAttr("data.statuspal_status_page.by_subdomain", "status_page.domain_config.status", "active"),
+					resource.TestCheckResourceAttr("data.statuspal_status_page.by_name", "status_page.subdomain", "acme"),
+					resource.TestCheckResourceAttr("data.statuspal_status_page.by_name", "status_page.time_zone", "Europe/Berlin"),
+					resource.TestCheckNoResourceAttr("data.statuspal_status_page.by_name", "status_page.domain_config.provider"),
+					resource.TestCheckResourceAttr("data.statuspal_status_page.by_name_regex", "status_page.subdomain", "acme-staging"),
+				),
+			},
+		},
+	})
+}
@@ -758,11 +758,12 @@ func (r *statusPageResource) Create(ctx context.Context, req resource.CreateRequ
 
 	// Create new status page
 	organizationID := plan.OrganizationID.ValueString()
-	newStatusPage, err := r.client.CreateStatusPage(statusPage, &organizationID)
+	newStatusPage, err := r.client.CreateStatusPage(ctx, statusPage, &organizationID)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addClientError(&resp.Diagnostics, path.Root("status_page"),
 			"Error creating StatusPal StatusPage",
-			"Could not create status page, unexpected error: "+err.Error(),
+			"Could not create status page, unexpected error: ",
+			err,
 		)
 		return
 	}
@@ -809,7 +810,7 @@ func (r *statusPageResource) Read(ctx context.Context, req resource.ReadRequest,
 	// Get refreshed status page value from StatusPal
 	organizationID := state.OrganizationID.ValueString()
 	subdomain := state.StatusPage.Subdomain.ValueString()
-	statusPage, err := r.client.GetStatusPage(&organizationID, &subdomain)
+	statusPage, err := r.client.GetStatusPage(ctx, &organizationID, &subdomain)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading StatusPal StatusPage",
@@ -873,7 +874,7 @@ func (r *statusPageResource) Update(ctx context.Context, req resource.UpdateRequ
 		clearPage.DomainConfig = nil
 		clearPage.Domain = ""
 		clearPage.CustomDomainEnabled = false
-		_, err := r.client.UpdateStatusPage(&clearPage, &organizationID, &subdomain)
+		_, err := r.client.UpdateStatusPage(ctx, &clearPage, &organizationID, &subdomain)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error clearing legacy domain before migration",
@@ -884,11 +885,12 @@ func (r *statusPageResource) Update(ctx context.Context, req resource.UpdateRequ
 	}
 
 	// Update existing status page
-	updatedStatusPage, err := r.client.UpdateStatusPage(statusPage, &organizationID, &subdomain)
+	updatedStatusPage, err := r.client.UpdateStatusPage(ctx, statusPage, &organizationID, &subdomain)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addClientError(&resp.Diagnostics, path.Root("status_page"),
 			"Error Updating StatusPal StatusPage",
-			"Could not Update status page, unexpected error: "+err.Error(),
+			"Could not Update status page, unexpected error: ",
+			err,
 		)
 		return
 	}
@@ -939,7 +941,7 @@ func (r *statusPageResource) Delete(ctx context.Context, req resource.DeleteRequ
 	// Delete existing order
 	organizationID := state.OrganizationID.ValueString()
 	subdomain := state.StatusPage.Subdomain.ValueString()
-	err := r.client.DeleteStatusPage(&organizationID, &subdomain)
+	err := r.client.DeleteStatusPage(ctx, &organizationID, &subdomain)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Deleting StatusPal StatusPage",
@@ -1040,7 +1042,7 @@ func pollBunnyValidationRecords(
 			return nil, err
 		}
 
-		sp, err := client.GetStatusPage(&orgID, &subdomain)
+		sp, err := client.GetStatusPage(ctx, &orgID, &subdomain)
 		if err != nil {
 			return nil, err
 		}
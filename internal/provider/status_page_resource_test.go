@@ -993,3 +993,34 @@ func TestAccStatusPageResource_BunnyDomain(t *testing.T) {
 		},
 	})
 }
+
+func TestAccStatusPageResource_ValidationErrors(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /orgs/1/status_pages", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		_, _ = w.Write([]byte(`{"errors": {"subdomain": ["has already been taken"]}}`))
+	})
+
+	mockServer := httptest.NewServer(mux)
+	defer mockServer.Close()
+	providerConfig := providerConfig(&mockServer.URL)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// The API validation errors are reported on the status_page attribute
+			{
+				Config: *providerConfig + `resource "statuspal_status_page" "test" {
+					organization_id = "1"
+					status_page = {
+						name      = "Test Status Page from Terraform"
+						url       = "terraform.test"
+						time_zone = "Europe/Budapest"
+						subdomain = "taken"
+					}
+				}`,
+				ExpectError: regexp.MustCompile(`(?s)Error creating StatusPal StatusPage.*with statuspal_status_page.test,.*status_page = \{.*The StatusPal API rejected the subdomain value: has already been\s+taken`),
+			},
+		},
+	})
+}
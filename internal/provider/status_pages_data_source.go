@@ -4,9 +4,11 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	statuspal "terraform-provider-statuspal/internal/client"
@@ -32,6 +34,7 @@ type statusPagesDataSource struct {
 type statusPagesDataSourceModel struct {
 	ID             types.String       `tfsdk:"id"` // only for test case
 	OrganizationID types.String       `tfsdk:"organization_id"`
+	Limit          types.Int64        `tfsdk:"limit"`
 	StatusPages    []statusPagesModel `tfsdk:"status_pages"`
 }
 
@@ -137,135 +140,151 @@ func (d *statusPagesDataSource) Schema(_ context.Context, _ datasource.SchemaReq
 				Description: "The organization ID of the status pages.",
 				Required:    true,
 			},
+			"limit": schema.Int64Attribute{
+				Description: "The maximum number of status pages to return. By default, all the status pages of the organization are returned.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
 			"status_pages": schema.ListNestedAttribute{
 				Description: "List of status pages.",
 				Computed:    true,
 				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"name": schema.StringAttribute{
-							Description: "Company, project or service name.",
-							Computed:    true,
-						},
-						"url": schema.StringAttribute{
-							Description: "The website to your company, project or service.",
-							Computed:    true,
-						},
-						"time_zone": schema.StringAttribute{
-							Description: `The primary timezone the status page uses to display incidents (e.g. "Europe/Berlin").`,
-							Computed:    true,
-						},
-						"subdomain": schema.StringAttribute{
-							Description: "The status page subdomain on statuspal.",
-							Computed:    true,
-						},
-						"support_email": schema.StringAttribute{
-							Description: "Your company's support email.",
-							Computed:    true,
-						},
-						"twitter_public_screen_name": schema.StringAttribute{
-							Description: "Twitter handle name (e.g. yourcompany).",
-							Computed:    true,
-						},
-						"about": schema.StringAttribute{
-							Description: "Customize the about information displayed in your status page.",
-							Computed:    true,
-						},
-						"display_about": schema.BoolAttribute{
-							Description: "Display about information.",
-							Computed:    true,
-						},
-						"custom_domain_enabled": schema.BoolAttribute{
-							Description:        "Enable your custom domain with SSL.",
-							DeprecationMessage: "Legacy custom domains are no longer supported. Use the domain_config block on the statuspal_status_page resource instead. This attribute will be removed in a future version.",
-							Computed:           true,
-						},
-						"domain": schema.StringAttribute{
-							Description:        "Configure your own domain to point to your status page (e.g. status.your-company.com), we generate and auto-renew its SSL certificate for you.",
-							DeprecationMessage: "Legacy custom domains are no longer supported. Use domain_config.domain on the statuspal_status_page resource instead. This attribute will be removed in a future version.",
-							Computed:           true,
-						},
-						"restricted_ips": schema.StringAttribute{
-							Description: `Your status page will be accessible only from this IPs (e.g. "1.1.1.1, 2.2.2.2").`,
-							Computed:    true,
-						},
-						"member_restricted": schema.BoolAttribute{
-							Description: "Only signed in members will be allowed to access your status page.",
-							Computed:    true,
-						},
-						"scheduled_maintenance_days": schema.Int64Attribute{
-							Description: "Display scheduled maintenance.",
-							Computed:    true,
-						},
-						"custom_js": schema.StringAttribute{
-							MarkdownDescription: "We'll insert this content inside the `<script>` tag at the bottom of your status page `<body>` tag.",
-							Computed:            true,
-						},
-						"head_code": schema.StringAttribute{
-							MarkdownDescription: "We'll insert this content inside the `<head>` tag.",
-							Computed:            true,
-						},
-						"date_format": schema.StringAttribute{
-							Description: "Display timestamps of incidents and updates in this format.",
-							Computed:    true,
-						},
-						"time_format": schema.StringAttribute{
-							Description: "Display timestamps of incidents and updates in this format.",
-							Computed:    true,
-						},
-						"date_format_enforce_everywhere": schema.BoolAttribute{
-							Description: "The above date format will be used everywhere in the status page. Timezone conversion to client's will be disabled.",
-							Computed:    true,
-						},
-						"display_calendar": schema.BoolAttribute{
-							Description: "Display uptime calendar at status page.",
-							Computed:    true,
-						},
-						"hide_watermark": schema.BoolAttribute{
-							Description: `Hide "Powered by Statuspal.io".`,
-							Computed:    true,
-						},
-						"minor_notification_hours": schema.Int64Attribute{
-							Description: "Long-running incident notification (Minor incident).",
-							Computed:    true,
-						},
-						"major_notification_hours": schema.Int64Attribute{
-							Description: "Long-running incident notification (Major incident).",
-							Computed:    true,
-						},
-						"maintenance_notification_hours": schema.Int64Attribute{
-							Description: "Long-running incident notification (Maintenance).",
-							Computed:    true,
-						},
-						"history_limit_days": schema.Int64Attribute{
-							Description: "Incident history limit (omit for No Limit).",
-							Computed:    true,
-						},
-						"custom_incident_types_enabled": schema.BoolAttribute{
-							Description: "Enable custom incident types.",
-							Computed:    true,
-						},
-						"info_notices_enabled": schema.BoolAttribute{
-							Description: "Enable information notices.",
-							Computed:    true,
-						},
-						"locked_when_maintenance": schema.BoolAttribute{
-							Description: "Lock from adding incidents when under maintenance.",
-							Computed:    true,
-						},
-						"noindex": schema.BoolAttribute{
-							Description: "Remove status page from being indexed by search engines (e.g. Google).",
-							Computed:    true,
-						},
-						"enable_auto_translations": schema.BoolAttribute{
-							Description: "Enable auto translations when creating incidents, maintenances and info notices.",
-							Computed:    true,
-						},
-						"captcha_enabled": schema.BoolAttribute{
-							Description: "Enable captchas (this option is only available when the status page is member restricted).",
-							Computed:    true,
-						},
-						"translations": schema.MapNestedAttribute{
-							MarkdownDescription: "A translations object. For example:\n  ```terraform" + `
+					Attributes: statusPageDataSourceAttributes(),
+				},
+			},
+		},
+	}
+}
+
+// statusPageDataSourceAttributes returns the attributes of a status page in the data sources.
+func statusPageDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"name": schema.StringAttribute{
+			Description: "Company, project or service name.",
+			Computed:    true,
+		},
+		"url": schema.StringAttribute{
+			Description: "The website to your company, project or service.",
+			Computed:    true,
+		},
+		"time_zone": schema.StringAttribute{
+			Description: `The primary timezone the status page uses to display incidents (e.g. "Europe/Berlin").`,
+			Computed:    true,
+		},
+		"subdomain": schema.StringAttribute{
+			Description: "The status page subdomain on statuspal.",
+			Computed:    true,
+		},
+		"support_email": schema.StringAttribute{
+			Description: "Your company's support email.",
+			Computed:    true,
+		},
+		"twitter_public_screen_name": schema.StringAttribute{
+			Description: "Twitter handle name (e.g. yourcompany).",
+			Computed:    true,
+		},
+		"about": schema.StringAttribute{
+			Description: "Customize the about information displayed in your status page.",
+			Computed:    true,
+		},
+		"display_about": schema.BoolAttribute{
+			Description: "Display about information.",
+			Computed:    true,
+		},
+		"custom_domain_enabled": schema.BoolAttribute{
+			Description:        "Enable your custom domain with SSL.",
+			DeprecationMessage: "Legacy custom domains are no longer supported. Use the domain_config block on the statuspal_status_page resource instead. This attribute will be removed in a future version.",
+			Computed:           true,
+		},
+		"domain": schema.StringAttribute{
+			Description:        "Configure your own domain to point to your status page (e.g. status.your-company.com), we generate and auto-renew its SSL certificate for you.",
+			DeprecationMessage: "Legacy custom domains are no longer supported. Use domain_config.domain on the statuspal_status_page resource instead. This attribute will be removed in a future version.",
+			Computed:           true,
+		},
+		"restricted_ips": schema.StringAttribute{
+			Description: `Your status page will be accessible only from this IPs (e.g. "1.1.1.1, 2.2.2.2").`,
+			Computed:    true,
+		},
+		"member_restricted": schema.BoolAttribute{
+			Description: "Only signed in members will be allowed to access your status page.",
+			Computed:    true,
+		},
+		"scheduled_maintenance_days": schema.Int64Attribute{
+			Description: "Display scheduled maintenance.",
+			Computed:    true,
+		},
+		"custom_js": schema.StringAttribute{
+			MarkdownDescription: "We'll insert this content inside the `<script>` tag at the bottom of your status page `<body>` tag.",
+			Computed:            true,
+		},
+		"head_code": schema.StringAttribute{
+			MarkdownDescription: "We'll insert this content inside the `<head>` tag.",
+			Computed:            true,
+		},
+		"date_format": schema.StringAttribute{
+			Description: "Display timestamps of incidents and updates in this format.",
+			Computed:    true,
+		},
+		"time_format": schema.StringAttribute{
+			Description: "Display timestamps of incidents and updates in this format.",
+			Computed:    true,
+		},
+		"date_format_enforce_everywhere": schema.BoolAttribute{
+			Description: "The above date format will be used everywhere in the status page. Timezone conversion to client's will be disabled.",
+			Computed:    true,
+		},
+		"display_calendar": schema.BoolAttribute{
+			Description: "Display uptime calendar at status page.",
+			Computed:    true,
+		},
+		"hide_watermark": schema.BoolAttribute{
+			Description: `Hide "Powered by Statuspal.io".`,
+			Computed:    true,
+		},
+		"minor_notification_hours": schema.Int64Attribute{
+			Description: "Long-running incident notification (Minor incident).",
+			Computed:    true,
+		},
+		"major_notification_hours": schema.Int64Attribute{
+			Description: "Long-running incident notification (Major incident).",
+			Computed:    true,
+		},
+		"maintenance_notification_hours": schema.Int64Attribute{
+			Description: "Long-running incident notification (Maintenance).",
+			Computed:    true,
+		},
+		"history_limit_days": schema.Int64Attribute{
+			Description: "Incident history limit (omit for No Limit).",
+			Computed:    true,
+		},
+		"custom_incident_types_enabled": schema.BoolAttribute{
+			Description: "Enable custom incident types.",
+			Computed:    true,
+		},
+		"info_notices_enabled": schema.BoolAttribute{
+			Description: "Enable information notices.",
+			Computed:    true,
+		},
+		"locked_when_maintenance": schema.BoolAttribute{
+			Description: "Lock from adding incidents when under maintenance.",
+			Computed:    true,
+		},
+		"noindex": schema.BoolAttribute{
+			Description: "Remove status page from being indexed by search engines (e.g. Google).",
+			Computed:    true,
+		},
+		"enable_auto_translations": schema.BoolAttribute{
+			Description: "Enable auto translations when creating incidents, maintenances and info notices.",
+			Computed:    true,
+		},
+		"captcha_enabled": schema.BoolAttribute{
+			Description: "Enable captchas (this option is only available when the status page is member restricted).",
+			Computed:    true,
+		},
+		"translations": schema.MapNestedAttribute{
+			MarkdownDescription: "A translations object. For example:\n  ```terraform" + `
 	{
 		en = {
 			public_company_name = "Your company"
@@ -277,204 +296,200 @@ func (d *statusPagesDataSource) Schema(_ context.Context, _ datasource.SchemaReq
 		}
 	}
 ` + "  ```\n→ ",
-							Computed: true,
-							NestedObject: schema.NestedAttributeObject{
-								Attributes: map[string]schema.Attribute{
-									"public_company_name": schema.StringAttribute{
-										Description: "Displayed at the footer of the status page.",
-										Computed:    true,
-									},
-									"header_logo_text": schema.StringAttribute{
-										Description: "Displayed at the header of the status page.",
-										Computed:    true,
-									},
-								},
-							},
-						},
-						"header_logo_text": schema.StringAttribute{
-							Description: "Displayed at the header of the status page.",
-							Computed:    true,
-						},
-						"public_company_name": schema.StringAttribute{
-							Description: "Displayed at the footer of the status page.",
-							Computed:    true,
-						},
-						"bg_image": schema.StringAttribute{
-							Description: "Background image url of the status page.",
-							Computed:    true,
-						},
-						"logo": schema.StringAttribute{
-							Description: "Logo url of the status page.",
-							Computed:    true,
-						},
-						"favicon": schema.StringAttribute{
-							Description: "Favicon url of the status page.",
-							Computed:    true,
-						},
-						"display_uptime_graph": schema.BoolAttribute{
-							Description: "Display the uptime graph in the status page.",
-							Computed:    true,
-						},
-						"uptime_graph_days": schema.Int64Attribute{
-							Description: "Uptime graph period.",
-							Computed:    true,
-						},
-						"current_incidents_position": schema.StringAttribute{
-							Description: `The incident position displayed in the status page, it can be "below_services" and "above_services".`,
-							Computed:    true,
-						},
-						"theme_selected": schema.StringAttribute{
-							Description: `The selected theme for state page, it can be "default" and "big-logo".`,
-							Computed:    true,
-						},
-						"link_color": schema.StringAttribute{
-							Description: "The links color in the status page.",
-							Computed:    true,
-						},
-						"header_bg_color1": schema.StringAttribute{
-							Description: "The background color at left side of the status page header.",
-							Computed:    true,
-						},
-						"header_bg_color2": schema.StringAttribute{
-							Description: "The background color at right side of the status page header.",
-							Computed:    true,
-						},
-						"header_fg_color": schema.StringAttribute{
-							Description: "The text color in the status page.",
-							Computed:    true,
-						},
-						"incident_header_color": schema.StringAttribute{
-							Description: "Incidents header color in the status page.",
-							Computed:    true,
-						},
-						"incident_link_color": schema.StringAttribute{
-							Description: "Incidents link color in the status page.",
-							Computed:    true,
-						},
-						"status_ok_color": schema.StringAttribute{
-							Description: "The status page colors when there is no incident.",
-							Computed:    true,
-						},
-						"status_minor_color": schema.StringAttribute{
-							Description: "The status page colors when there is a minor incident.",
-							Computed:    true,
-						},
-						"status_major_color": schema.StringAttribute{
-							Description: "The status page colors when there is a major incident.",
-							Computed:    true,
-						},
-						"status_maintenance_color": schema.StringAttribute{
-							Description: "The status page colors when there is a maintenance incident.",
-							Computed:    true,
-						},
-						"custom_css": schema.StringAttribute{
-							MarkdownDescription: "We'll insert this content inside the `<style>` tag.",
-							Computed:            true,
-						},
-						"custom_header": schema.StringAttribute{
-							MarkdownDescription: "A custom header for the status page (e.g. \"`<header>...</header>`\").",
-							Computed:            true,
-						},
-						"custom_footer": schema.StringAttribute{
-							MarkdownDescription: "A custom footer for the status page (e.g. \"`<footer>...</footer>`\").",
-							Computed:            true,
-						},
-						"notify_by_default": schema.BoolAttribute{
-							Description: "Check the Notify subscribers checkbox by default.",
-							Computed:    true,
-						},
-						"tweet_by_default": schema.BoolAttribute{
-							Description: "Check the Tweet checkbox by default.",
-							Computed:    true,
-						},
-						"slack_subscriptions_enabled": schema.BoolAttribute{
-							Description: "Allow your customers to subscribe via Slack to updates on your status page's status.",
-							Computed:    true,
-						},
-						"discord_notifications_enabled": schema.BoolAttribute{
-							Description: "Allow your customers to receive notifications on a Discord channel.",
-							Computed:    true,
-						},
-						"teams_notifications_enabled": schema.BoolAttribute{
-							Description: "Allow your customers to receive notifications on Microsoft Teams.",
-							Computed:    true,
-						},
-						"google_chat_notifications_enabled": schema.BoolAttribute{
-							Description: "Allow your customers to receive notifications on Google Chat.",
-							Computed:    true,
-						},
-						"mattermost_notifications_enabled": schema.BoolAttribute{
-							Description: "Allow your customers to receive notifications on Mattermost.",
-							Computed:    true,
-						},
-						"sms_notifications_enabled": schema.BoolAttribute{
-							Description: "Allow your customers to receive SMS notifications on your status page's status (to enable this you need to have a Twilio or Esendex integration).",
-							Computed:    true,
-						},
-						"zoom_notifications_enabled": schema.BoolAttribute{
-							Description: "Allow your customers to receive notifications on Zoom.",
-							Computed:    true,
-						},
-						"feed_enabled": schema.BoolAttribute{
-							Description: "Allow your customers to receive updates as RSS and Atom feeds.",
-							Computed:    true,
-						},
-						"calendar_enabled": schema.BoolAttribute{
-							Description: "Allow your customers to receive updates via iCalendar feed.",
-							Computed:    true,
-						},
-						"google_calendar_enabled": schema.BoolAttribute{
-							Description: "Allow your customers to import Google Calendar with Status Pages maintenance (business only).",
-							Computed:    true,
-						},
-						"subscribers_enabled": schema.BoolAttribute{
-							Description: "Allow email customers to receive email notifications.",
-							Computed:    true,
-						},
-						"notification_email": schema.StringAttribute{
-							Description: "Allow your customers to subscribe via email to updates on your status page's status.",
-							Computed:    true,
-						},
-						"reply_to_email": schema.StringAttribute{
-							Description: "The email address we'll use in the 'reply_to' field in emails to your subscribers. So they can reply to your notification emails.",
-							Computed:    true,
-						},
-						"tweeting_enabled": schema.BoolAttribute{
-							Description: "Allows to send tweets when creating or updating an incident.",
-							Computed:    true,
-						},
-						"email_layout_template": schema.StringAttribute{
-							MarkdownDescription: "Custom email layout template, see the documentation: [Custom email templates](https://docs.statuspal.io/platform/subscriptions-and-notifications/custom-email-templates).",
-							Computed:            true,
-						},
-						"email_confirmation_template": schema.StringAttribute{
-							MarkdownDescription: "Custom confirmation email template, see the documentation: [Custom email templates](https://docs.statuspal.io/platform/subscriptions-and-notifications/custom-email-templates).",
-							Computed:            true,
-						},
-						"email_notification_template": schema.StringAttribute{
-							MarkdownDescription: "Custom email notification template, see the documentation: [Custom email templates](https://docs.statuspal.io/platform/subscriptions-and-notifications/custom-email-templates).",
-							Computed:            true,
-						},
-						"email_templates_enabled": schema.BoolAttribute{
-							Description: "The templates won't be used until this is enabled, but you can send test emails.",
-							Computed:    true,
-						},
-						"allowed_email_domains": schema.StringAttribute{
-							Description: "Users with these domains in their email address will be able to sign up via status page invite link. Each domain should be separated by `\\n` (e.g., `acme.corp\\nnapster.com`).",
-							Computed:    true,
-						},
-						"inserted_at": schema.StringAttribute{
-							Description: "Datetime at which the status page was inserted.",
-							Computed:    true,
-						},
-						"updated_at": schema.StringAttribute{
-							Description: "Datetime at which the status page was last updated.",
-							Computed:    true,
-						},
+			Computed: true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"public_company_name": schema.StringAttribute{
+						Description: "Displayed at the footer of the status page.",
+						Computed:    true,
+					},
+					"header_logo_text": schema.StringAttribute{
+						Description: "Displayed at the header of the status page.",
+						Computed:    true,
 					},
 				},
 			},
 		},
+		"header_logo_text": schema.StringAttribute{
+			Description: "Displayed at the header of the status page.",
+			Computed:    true,
+		},
+		"public_company_name": schema.StringAttribute{
+			Description: "Displayed at the footer of the status page.",
+			Computed:    true,
+		},
+		"bg_image": schema.StringAttribute{
+			Description: "Background image url of the status page.",
+			Computed:    true,
+		},
+		"logo": schema.StringAttribute{
+			Description: "Logo url of the status page.",
+			Computed:    true,
+		},
+		"favicon": schema.StringAttribute{
+			Description: "Favicon url of the status page.",
+			Computed:    true,
+		},
+		"display_uptime_graph": schema.BoolAttribute{
+			Description: "Display the uptime graph in the status page.",
+			Computed:    true,
+		},
+		"uptime_graph_days": schema.Int64Attribute{
+			Description: "Uptime graph period.",
+			Computed:    true,
+		},
+		"current_incidents_position": schema.StringAttribute{
+			Description: `The incident position displayed in the status page, it can be "below_services" and "above_services".`,
+			Computed:    true,
+		},
+		"theme_selected": schema.StringAttribute{
+			Description: `The selected theme for state page, it can be "default" and "big-logo".`,
+			Computed:    true,
+		},
+		"link_color": schema.StringAttribute{
+			Description: "The links color in the status page.",
+			Computed:    true,
+		},
+		"header_bg_color1": schema.StringAttribute{
+			Description: "The background color at left side of the status page header.",
+			Computed:    true,
+		},
+		"header_bg_color2": schema.StringAttribute{
+			Description: "The background color at right side of the status page header.",
+			Computed:    true,
+		},
+		"header_fg_color": schema.StringAttribute{
+			Description: "The text color in the status page.",
+			Computed:    true,
+		},
+		"incident_header_color": schema.StringAttribute{
+			Description: "Incidents header color in the status page.",
+			Computed:    true,
+		},
+		"incident_link_color": schema.StringAttribute{
+			Description: "Incidents link color in the status page.",
+			Computed:    true,
+		},
+		"status_ok_color": schema.StringAttribute{
+			Description: "The status page colors when there is no incident.",
+			Computed:    true,
+		},
+		"status_minor_color": schema.StringAttribute{
+			Description: "The status page colors when there is a minor incident.",
+			Computed:    true,
+		},
+		"status_major_color": schema.StringAttribute{
+			Description: "The status page colors when there is a major incident.",
+			Computed:    true,
+		},
+		"status_maintenance_color": schema.StringAttribute{
+			Description: "The status page colors when there is a maintenance incident.",
+			Computed:    true,
+		},
+		"custom_css": schema.StringAttribute{
+			MarkdownDescription: "We'll insert this content inside the `<style>` tag.",
+			Computed:            true,
+		},
+		"custom_header": schema.StringAttribute{
+			MarkdownDescription: "A custom header for the status page (e.g. \"`<header>...</header>`\").",
+			Computed:            true,
+		},
+		"custom_footer": schema.StringAttribute{
+			MarkdownDescription: "A custom footer for the status page (e.g. \"`<footer>...</footer>`\").",
+			Computed:            true,
+		},
+		"notify_by_default": schema.BoolAttribute{
+			Description: "Check the Notify subscribers checkbox by default.",
+			Computed:    true,
+		},
+		"tweet_by_default": schema.BoolAttribute{
+			Description: "Check the Tweet checkbox by default.",
+			Computed:    true,
+		},
+		"slack_subscriptions_enabled": schema.BoolAttribute{
+			Description: "Allow your customers to subscribe via Slack to updates on your status page's status.",
+			Computed:    true,
+		},
+		"discord_notifications_enabled": schema.BoolAttribute{
+			Description: "Allow your customers to receive notifications on a Discord channel.",
+			Computed:    true,
+		},
+		"teams_notifications_enabled": schema.BoolAttribute{
+			Description: "Allow your customers to receive notifications on Microsoft Teams.",
+			Computed:    true,
+		},
+		"google_chat_notifications_enabled": schema.BoolAttribute{
+			Description: "Allow your customers to receive notifications on Google Chat.",
+			Computed:    true,
+		},
+		"mattermost_notifications_enabled": schema.BoolAttribute{
+			Description: "Allow your customers to receive notifications on Mattermost.",
+			Computed:    true,
+		},
+		"sms_notifications_enabled": schema.BoolAttribute{
+			Description: "Allow your customers to receive SMS notifications on your status page's status (to enable this you need to have a Twilio or Esendex integration).",
+			Computed:    true,
+		},
+		"zoom_notifications_enabled": schema.BoolAttribute{
+			Description: "Allow your customers to receive notifications on Zoom.",
+			Computed:    true,
+		},
+		"feed_enabled": schema.BoolAttribute{
+			Description: "Allow your customers to receive updates as RSS and Atom feeds.",
+			Computed:    true,
+		},
+		"calendar_enabled": schema.BoolAttribute{
+			Description: "Allow your customers to receive updates via iCalendar feed.",
+			Computed:    true,
+		},
+		"google_calendar_enabled": schema.BoolAttribute{
+			Description: "Allow your customers to import Google Calendar with Status Pages maintenance (business only).",
+			Computed:    true,
+		},
+		"subscribers_enabled": schema.BoolAttribute{
+			Description: "Allow email customers to receive email notifications.",
+			Computed:    true,
+		},
+		"notification_email": schema.StringAttribute{
+			Description: "Allow your customers to subscribe via email to updates on your status page's status.",
+			Computed:    true,
+		},
+		"reply_to_email": schema.StringAttribute{
+			Description: "The email address we'll use in the 'reply_to' field in emails to your subscribers. So they can reply to your notification emails.",
+			Computed:    true,
+		},
+		"tweeting_enabled": schema.BoolAttribute{
+			Description: "Allows to send tweets when creating or updating an incident.",
+			Computed:    true,
+		},
+		"email_layout_template": schema.StringAttribute{
+			MarkdownDescription: "Custom email layout template, see the documentation: [Custom email templates](https://docs.statuspal.io/platform/subscriptions-and-notifications/custom-email-templates).",
+			Computed:            true,
+		},
+		"email_confirmation_template": schema.StringAttribute{
+			MarkdownDescription: "Custom confirmation email template, see the documentation: [Custom email templates](https://docs.statuspal.io/platform/subscriptions-and-notifications/custom-email-templates).",
+			Computed:            true,
+		},
+		"email_notification_template": schema.StringAttribute{
+			MarkdownDescription: "Custom email notification template, see the documentation: [Custom email templates](https://docs.statuspal.io/platform/subscriptions-and-notifications/custom-email-templates).",
+			Computed:            true,
+		},
+		"email_templates_enabled": schema.BoolAttribute{
+			Description: "The templates won't be used until this is enabled, but you can send test emails.",
+			Computed:    true,
+		},
+		"allowed_email_domains": schema.StringAttribute{
+			Description: "Users with these domains in their email address will be able to sign up via status page invite link. Each domain should be separated by `\\n` (e.g., `acme.corp\\nnapster.com`).",
+			Computed:    true,
+		},
+		"inserted_at": schema.StringAttribute{
+			Description: "Datetime at which the status page was inserted.",
+			Computed:    true,
+		},
+		"updated_at": schema.StringAttribute{
+			Description: "Datetime at which the status page was last updated.",
+			Computed:    true,
+		},
 	}
 }
 
@@ -489,7 +504,12 @@ func (d *statusPagesDataSource) Read(ctx context.Context, req datasource.ReadReq
 	}
 
 	organizationID := state.OrganizationID.ValueString()
-	statusPages, err := d.client.GetStatusPages(&organizationID)
+	limit := state.Limit.ValueInt64()
+	statusPages := []statuspal.StatusPage{}
+	err := d.client.ListStatusPages(ctx, &organizationID, func(page []statuspal.StatusPage) bool {
+		statusPages = append(statusPages, page...)
+		return limit == 0 || int64(len(statusPages)) < limit
+	})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read StatusPal StatusPages",
@@ -498,8 +518,12 @@ func (d *statusPagesDataSource) Read(ctx context.Context, req datasource.ReadReq
 		return
 	}
 
+	if limit > 0 && int64(len(statusPages)) > limit {
+		statusPages = statusPages[:limit]
+	}
+
 	// Map response body to model
-	for _, statusPage := range *statusPages {
+	for _, statusPage := range statusPages {
 		// Define the translation object schema
 		translationSchema := map[string]attr.Type{
 			"public_company_name": types.StringType,
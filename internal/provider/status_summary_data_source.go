@@ -0,0 +1,394 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	statuspal "terraform-provider-statuspal/internal/client"
+)
+
+// The overall statuses of a status page, from the least to the most severe.
+const (
+	statusOperational = "operational"
+	statusMaintenance = "maintenance"
+	statusMinor       = "minor"
+	statusMajor       = "major"
+)
+
+// statusSeverities ranks the overall statuses, from the least to the most severe.
+var statusSeverities = map[string]int{
+	statusOperational: 0,
+	statusMaintenance: 1,
+	statusMinor:       2,
+	statusMajor:       3,
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &statusSummaryDataSource{}
+	_ datasource.DataSourceWithConfigure = &statusSummaryDataSource{}
+)
+
+// NewStatusSummaryDataSource is a helper function to simplify the provider implementation.
+func NewStatusSummaryDataSource() datasource.DataSource {
+	return &statusSummaryDataSource{}
+}
+
+// statusSummaryDataSource is the data source implementation.
+type statusSummaryDataSource struct {
+	client *statuspal.Client
+}
+
+// statusSummaryDataSourceModel maps the data source schema data.
+type statusSummaryDataSourceModel struct {
+	ID                  types.String                 `tfsdk:"id"` // only for test case
+	StatusPageSubdomain types.String                 `tfsdk:"status_page_subdomain"`
+	Status              types.String                 `tfsdk:"status"`
+	AllServicesUp       types.Bool                   `tfsdk:"all_services_up"`
+	OngoingIncidents    []statusSummaryIncidentModel `tfsdk:"ongoing_incidents"`
+	OngoingMaintenances []statusSummaryIncidentModel `tfsdk:"ongoing_maintenances"`
+	Services            []statusSummaryServiceModel  `tfsdk:"services"`
+}
+
+// statusSummaryIncidentModel maps an ongoing incident or maintenance of the summary.
+type statusSummaryIncidentModel struct {
+	ID         types.String   `tfsdk:"id"`
+	Title      types.String   `tfsdk:"title"`
+	Type       types.String   `tfsdk:"type"`
+	Severity   types.String   `tfsdk:"severity"`
+	StartsAt   types.String   `tfsdk:"starts_at"`
+	ServiceIDs []types.String `tfsdk:"service_ids"`
+	Url        types.String   `tfsdk:"url"`
+}
+
+// statusSummaryServiceModel maps a service of the summary.
+type statusSummaryServiceModel struct {
+	ID                  types.String `tfsdk:"id"`
+	Name                types.String `tfsdk:"name"`
+	IsUp                types.Bool   `tfsdk:"is_up"`
+	CurrentIncidentType types.String `tfsdk:"current_incident_type"`
+	Severity            types.String `tfsdk:"severity"`
+}
+
+// Metadata returns the data source type name.
+func (d *statusSummaryDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_status_summary"
+}
+
+// Schema defines the schema for the data source.
+func (d *statusSummaryDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	statuses := quotedValuesDescription([]string{statusOperational, statusMaintenance, statusMinor, statusMajor})
+	incidentAttributes := func(kind string) map[string]schema.Attribute {
+		return map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: fmt.Sprintf("The ID of the %s.", kind),
+				Computed:    true,
+			},
+			"title": schema.StringAttribute{
+				Description: fmt.Sprintf("The title of the %s.", kind),
+				Computed:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: fmt.Sprintf(`The type of the %s: "minor", "major", "scheduled" or the ID of a custom incident type.`, kind),
+				Computed:    true,
+			},
+			"severity": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("The status the %s type puts the status page in. Enum: %s.", kind, statuses),
+				Computed:            true,
+			},
+			"starts_at": schema.StringAttribute{
+				Description: fmt.Sprintf("Datetime at which the %s started.", kind),
+				Computed:    true,
+			},
+			"service_ids": schema.ListAttribute{
+				Description: fmt.Sprintf("IDs of the services affected by the %s.", kind),
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"url": schema.StringAttribute{
+				Description: fmt.Sprintf("The URL of the %s on the status page.", kind),
+				Computed:    true,
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Fetches the current status of the status page: its overall status, its ongoing incidents and maintenances, and the status of each service.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier attribute. Ignore it, only used in testing.",
+				Computed:    true,
+			},
+			"status_page_subdomain": schema.StringAttribute{
+				Description: "The status page subdomain.",
+				Required:    true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf(
+					"Enum: %s\n  The overall status of the status page, the most severe status of the ongoing incidents and maintenances, "+
+						"and of the services. A service which is down without an ongoing incident counts as `%q`.",
+					statuses, statusMinor,
+				),
+				Computed: true,
+			},
+			"all_services_up": schema.BoolAttribute{
+				Description: "Are all the services of the status page up?",
+				Computed:    true,
+			},
+			"ongoing_incidents": schema.ListNestedAttribute{
+				Description: "List of incidents which started and are not closed yet.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: incidentAttributes("incident"),
+				},
+			},
+			"ongoing_maintenances": schema.ListNestedAttribute{
+				Description: "List of scheduled maintenances which started and are not finished yet.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: incidentAttributes("maintenance"),
+				},
+			},
+			"services": schema.ListNestedAttribute{
+				Description: "List of services of the status page with their current status.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the service.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the service.",
+							Computed:    true,
+						},
+						"is_up": schema.BoolAttribute{
+							Description: "Is the monitored service up?",
+							Computed:    true,
+						},
+						"current_incident_type": schema.StringAttribute{
+							Description: `The service's current incident type: "minor", "major", "scheduled", the ID of a custom incident type, or empty when there is no ongoing incident.`,
+							Computed:    true,
+						},
+						"severity": schema.StringAttribute{
+							MarkdownDescription: fmt.Sprintf("The status of the service, from its current incident type and whether it is up. Enum: %s.", statuses),
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *statusSummaryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Retrieve values from config
+	var state statusSummaryDataSourceModel
+	diagnostics := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diagnostics...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	statusPageSubdomain := state.StatusPageSubdomain.ValueString()
+	services, err := d.client.GetServices(ctx, &statusPageSubdomain)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read StatusPal Services",
+			err.Error(),
+		)
+		return
+	}
+
+	// The API has no filter on the ongoing incidents, and an incident may stay
+	// open long after newer ones ended, so every page is read.
+	now := time.Now()
+	ongoing := []statuspal.Incident{}
+	err = d.client.ListIncidents(ctx, &statusPageSubdomain, func(page []statuspal.Incident) bool {
+		for _, incident := range page {
+			if incidentOngoing(incident, now) {
+				ongoing = append(ongoing, incident)
+			}
+		}
+		return true
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read StatusPal Incidents",
+			err.Error(),
+		)
+		return
+	}
+
+	// The custom incident types are only fetched when they are in use
+	severities := map[string]string{
+		"":          statusOperational,
+		"scheduled": statusMaintenance,
+		"minor":     statusMinor,
+		"major":     statusMajor,
+	}
+	severity := func(incidentType string) (string, error) {
+		if severity, ok := severities[incidentType]; ok {
+			return severity, nil
+		}
+
+		customType, err := d.client.GetIncidentType(ctx, &statusPageSubdomain, &incidentType)
+		if statuspal.ErrorNotFound(err) {
+			// The custom incident type was deleted since
+			resp.Diagnostics.AddWarning(
+				"StatusPal Incident Type Not Found",
+				fmt.Sprintf("The incident type %s of the status page %q does not exist anymore, its severity is assumed to be minor.", incidentType, statusPageSubdomain),
+			)
+			severities[incidentType] = statusMinor
+			return statusMinor, nil
+		}
+		if err != nil {
+			return "", fmt.Errorf("could not read the incident type %s: %w", incidentType, err)
+		}
+		severities[incidentType] = customType.Severity
+		if _, ok := statusSeverities[customType.Severity]; !ok {
+			severities[incidentType] = statusMinor
+		}
+
+		return severities[incidentType], nil
+	}
+
+	status := statusOperational
+	worsen := func(severity string) {
+		if statusSeverities[severity] > statusSeverities[status] {
+			status = severity
+		}
+	}
+
+	// Map response body to model
+	state.AllServicesUp = types.BoolValue(true)
+	state.Services = []statusSummaryServiceModel{}
+	for _, service := range *services {
+		serviceSeverity, err := severity(service.CurrentIncidentType)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read StatusPal Incident Type",
+				err.Error(),
+			)
+			return
+		}
+		if !service.IsUp {
+			state.AllServicesUp = types.BoolValue(false)
+			if statusSeverities[serviceSeverity] < statusSeverities[statusMinor] {
+				serviceSeverity = statusMinor
+			}
+		}
+		worsen(serviceSeverity)
+
+		state.Services = append(state.Services, statusSummaryServiceModel{
+			ID:                  types.StringValue(strconv.FormatInt(service.ID, 10)),
+			Name:                types.StringValue(service.Name),
+			IsUp:                types.BoolValue(service.IsUp),
+			CurrentIncidentType: types.StringValue(service.CurrentIncidentType),
+			Severity:            types.StringValue(serviceSeverity),
+		})
+	}
+
+	state.OngoingIncidents = []statusSummaryIncidentModel{}
+	state.OngoingMaintenances = []statusSummaryIncidentModel{}
+	for _, incident := range ongoing {
+		incidentSeverity, err := severity(incident.Type)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read StatusPal Incident Type",
+				err.Error(),
+			)
+			return
+		}
+		worsen(incidentSeverity)
+
+		serviceIDs := []types.String{}
+		for _, serviceID := range incident.ServiceIDs {
+			serviceIDs = append(serviceIDs, types.StringValue(strconv.FormatInt(serviceID, 10)))
+		}
+		model := statusSummaryIncidentModel{
+			ID:         types.StringValue(strconv.FormatInt(incident.ID, 10)),
+			Title:      types.StringValue(incident.Title),
+			Type:       types.StringValue(incident.Type),
+			Severity:   types.StringValue(incidentSeverity),
+			StartsAt:   types.StringValue(incident.StartsAt),
+			ServiceIDs: serviceIDs,
+			Url:        types.StringValue(incident.Url),
+		}
+		if incidentSeverity == statusMaintenance {
+			state.OngoingMaintenances = append(state.OngoingMaintenances, model)
+		} else {
+			state.OngoingIncidents = append(state.OngoingIncidents, model)
+		}
+	}
+	state.Status = types.StringValue(status)
+	state.ID = types.StringValue("placeholder") // only for test case
+
+	// Set state
+	diagnostics = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diagnostics...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// incidentOngoing reports whether the incident started and did not end yet:
+// it has no end date, like the open incidents, or an end date in the future,
+// like the maintenances. An incident without a valid start date is considered
+// started, and one without a valid end date ended.
+func incidentOngoing(incident statuspal.Incident, now time.Time) bool {
+	if startsAt, err := parseDateTime(incident.StartsAt); err == nil && startsAt.After(now) {
+		return false
+	}
+
+	return !incidentEnded(incident, now)
+}
+
+// incidentEnded reports whether the incident has an end date in the past.
+func incidentEnded(incident statuspal.Incident, now time.Time) bool {
+	if incident.EndsAt == nil || *incident.EndsAt == "" {
+		return false
+	}
+
+	endsAt, err := parseDateTime(*incident.EndsAt)
+	return err != nil || !endsAt.After(now)
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *statusSummaryDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*statuspal.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf(
+				"Expected *statuspal.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	d.client = client
+}
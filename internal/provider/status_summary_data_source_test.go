@@ -0,0 +1,209 @@
+package provider
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccStatusSummaryDataSource(t *testing.T) {
+	upcomingAt := time.Now().UTC().Add(24 * time.Hour).Format("2006-01-02T15:04:05")
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /status_pages/example-com/services", func(w http.ResponseWriter, r *http.Request) {
+		if _, err := w.Write([]byte(`{
+			"links": {"next": null, "prev": null},
+			"services": [
+				{"id": 1, "name": "API", "is_up": true, "current_incident_type": ""},
+				{"id": 2, "name": "Website", "is_up": true, "current_incident_type": ""}
+			]
+		}`)); err != nil {
+			log.Printf(`Error writing "/status_pages/example-com/services" response: %v`, err)
+		}
+	})
+	mux.HandleFunc("GET /status_pages/example-com/incidents", func(w http.ResponseWriter, r *http.Request) {
+		if _, err := w.Write([]byte(fmt.Sprintf(`{
+			"links": {"next": null, "prev": null},
+			"incidents": [
+				{"id": 1, "title": "Outage", "type": "major", "starts_at": "2024-05-16T10:00:00", "ends_at": "2024-05-16T12:00:00", "service_ids": [1]},
+				{"id": 2, "title": "Upgrade", "type": "scheduled", "starts_at": %q, "ends_at": null, "service_ids": [2]}
+			]
+		}`, upcomingAt))); err != nil {
+			log.Printf(`Error writing "/status_pages/example-com/incidents" response: %v`, err)
+		}
+	})
+	mock := httptest.NewServer(mux)
+	defer mock.Close()
+
+	providerConfig := *providerConfig(&mock.URL)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Operational testing
+			{
+				Config: providerConfig + `
+data "statuspal_status_summary" "test" {
+  status_page_subdomain = "example-com"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.statuspal_status_summary.test", "status", "operational"),
+					resource.TestCheckResourceAttr("data.statuspal_status_summary.test", "all_services_up", "true"),
+					resource.TestCheckResourceAttr("data.statuspal_status_summary.test", "ongoing_incidents.#", "0"),
+					resource.TestCheckResourceAttr("data.statuspal_status_summary.test", "ongoing_maintenances.#", "0"),
+					resource.TestCheckResourceAttr("data.statuspal_status_summary.test", "services.#", "2"),
+					resource.TestCheckResourceAttr("data.statuspal_status_summary.test", "services.0.severity", "operational"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccStatusSummaryDataSource_majorIncident(t *testing.T) {
+	now := time.Now().UTC()
+	startsAt := now.Add(-time.Hour).Format("2006-01-02T15:04:05")
+	startedBefore := now.Add(-3 * time.Hour).Format("2006-01-02T15:04:05")
+	startedLongBefore := now.Add(-6 * time.Hour).Format("2006-01-02T15:04:05")
+	endedAt := now.Add(-2 * time.Hour).Format("2006-01-02T15:04:05")
+	endsLater := now.Add(time.Hour).Format("2006-01-02T15:04:05")
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /status_pages/example-com/services", func(w http.ResponseWriter, r *http.Request) {
+		if _, err := w.Write([]byte(`{
+			"links": {"next": null, "prev": null},
+			"services": [
+				{"id": 1, "name": "API", "is_up": false, "current_incident_type": "major"},
+				{"id": 2, "name": "Website", "is_up": true, "current_incident_type": "7"},
+				{"id": 3, "name": "Database", "is_up": true, "current_incident_type": "scheduled"}
+			]
+		}`)); err != nil {
+			log.Printf(`Error writing "/status_pages/example-com/services" response: %v`, err)
+		}
+	})
+	mux.HandleFunc("GET /status_pages/example-com/incidents", func(w http.ResponseWriter, r *http.Request) {
+		// The incidents are listed from the most recent one
+		if _, err := w.Write([]byte(fmt.Sprintf(`{
+			"links": {"next": null, "prev": null},
+			"incidents": [
+				{"id": 3, "title": "API down", "type": "major", "starts_at": %[1]q, "ends_at": null, "service_ids": [1], "url": "https://example-com.statuspal.io/incidents/3"},
+				{"id": 4, "title": "Slow website", "type": "7", "starts_at": %[1]q, "ends_at": null, "service_ids": [2]},
+				{"id": 5, "title": "Database upgrade", "type": "scheduled", "starts_at": %[1]q, "ends_at": %[2]q, "service_ids": [3]},
+				{"id": 6, "title": "Slow search", "type": "9", "starts_at": %[1]q, "ends_at": null, "service_ids": [2]},
+				{"id": 7, "title": "Cache upgrade", "type": "scheduled", "starts_at": %[3]q, "ends_at": %[4]q, "service_ids": [3]},
+				{"id": 8, "title": "API errors", "type": "minor", "starts_at": %[5]q, "ends_at": %[4]q, "service_ids": [1]}
+			]
+		}`, startsAt, endsLater, startedBefore, endedAt, startedLongBefore))); err != nil {
+			log.Printf(`Error writing "/status_pages/example-com/incidents" response: %v`, err)
+		}
+	})
+	mux.HandleFunc("GET /status_pages/example-com/incident_types/7", func(w http.ResponseWriter, r *http.Request) {
+		if _, err := w.Write([]byte(`{"incident_type": {"id": 7, "name": "Degraded", "color": "FFA500", "severity": "minor"}}`)); err != nil {
+			log.Printf(`Error writing "/status_pages/example-com/incident_types/7" response: %v`, err)
+		}
+	})
+	mock := httptest.NewServer(mux)
+	defer mock.Close()
+
+	providerConfig := *providerConfig(&mock.URL)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Major incident testing
+			{
+				Config: providerConfig + `
+data "statuspal_status_summary" "test" {
+  status_page_subdomain = "example-com"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.statuspal_status_summary.test", "status", "major"),
+					resource.TestCheckResourceAttr("data.statuspal_status_summary.test", "all_services_up", "false"),
+					resource.TestCheckResourceAttr("data.statuspal_status_summary.test", "ongoing_incidents.#", "3"),
+					resource.TestCheckResourceAttr("data.statuspal_status_summary.test", "ongoing_incidents.0.id", "3"),
+					resource.TestCheckResourceAttr("data.statuspal_status_summary.test", "ongoing_incidents.0.severity", "major"),
+					resource.TestCheckResourceAttr("data.statuspal_status_summary.test", "ongoing_incidents.0.service_ids.0", "1"),
+					resource.TestCheckResourceAttr("data.statuspal_status_summary.test", "ongoing_incidents.0.url", "https://example-com.statuspal.io/incidents/3"),
+					resource.TestCheckResourceAttr("data.statuspal_status_summary.test", "ongoing_incidents.1.type", "7"),
+					resource.TestCheckResourceAttr("data.statuspal_status_summary.test", "ongoing_incidents.1.severity", "minor"),
+					resource.TestCheckResourceAttr("data.statuspal_status_summary.test", "ongoing_incidents.2.type", "9"),
+					resource.TestCheckResourceAttr("data.statuspal_status_summary.test", "ongoing_incidents.2.severity", "minor"),
+					resource.TestCheckResourceAttr("data.statuspal_status_summary.test", "ongoing_maintenances.#", "1"),
+					resource.TestCheckResourceAttr("data.statuspal_status_summary.test", "ongoing_maintenances.0.title", "Database upgrade"),
+					resource.TestCheckResourceAttr("data.statuspal_status_summary.test", "services.0.current_incident_type", "major"),
+					resource.TestCheckResourceAttr("data.statuspal_status_summary.test", "services.0.severity", "major"),
+					resource.TestCheckResourceAttr("data.statuspal_status_summary.test", "services.1.current_incident_type", "7"),
+					resource.TestCheckResourceAttr("data.statuspal_status_summary.test", "services.1.severity", "minor"),
+					resource.TestCheckResourceAttr("data.statuspal_status_summary.test", "services.2.severity", "maintenance"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccStatusSummaryDataSource_openIncidentOnLaterPage(t *testing.T) {
+	now := time.Now().UTC()
+	startedBefore := now.Add(-3 * time.Hour).Format("2006-01-02T15:04:05")
+	startedLongBefore := now.Add(-6 * time.Hour).Format("2006-01-02T15:04:05")
+	endedAt := now.Add(-2 * time.Hour).Format("2006-01-02T15:04:05")
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /status_pages/example-com/services", func(w http.ResponseWriter, r *http.Request) {
+		if _, err := w.Write([]byte(`{
+			"links": {"next": null, "prev": null},
+			"services": [
+				{"id": 1, "name": "API", "is_up": false, "current_incident_type": "major"}
+			]
+		}`)); err != nil {
+			log.Printf(`Error writing "/status_pages/example-com/services" response: %v`, err)
+		}
+	})
+	mux.HandleFunc("GET /status_pages/example-com/incidents", func(w http.ResponseWriter, r *http.Request) {
+		// The first page only holds an ended incident, the open one started earlier is on the next page
+		body := fmt.Sprintf(`{
+			"links": {"next": "1", "prev": null},
+			"incidents": [
+				{"id": 1, "title": "API errors", "type": "minor", "starts_at": %q, "ends_at": %q, "service_ids": [1]}
+			]
+		}`, startedBefore, endedAt)
+		if r.URL.Query().Get("after") == "1" {
+			body = fmt.Sprintf(`{
+				"links": {"next": null, "prev": null},
+				"incidents": [
+					{"id": 2, "title": "API down", "type": "major", "starts_at": %q, "ends_at": null, "service_ids": [1]}
+				]
+			}`, startedLongBefore)
+		}
+
+		if _, err := w.Write([]byte(body)); err != nil {
+			log.Printf(`Error writing "/status_pages/example-com/incidents" response: %v`, err)
+		}
+	})
+	mock := httptest.NewServer(mux)
+	defer mock.Close()
+
+	providerConfig := *providerConfig(&mock.URL)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Open incident on a later page testing
+			{
+				Config: providerConfig + `
+data "statuspal_status_summary" "test" {
+  status_page_subdomain = "example-com"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.statuspal_status_summary.test", "status", "major"),
+					resource.TestCheckResourceAttr("data.statuspal_status_summary.test", "ongoing_incidents.#", "1"),
+					resource.TestCheckResourceAttr("data.statuspal_status_summary.test", "ongoing_incidents.0.id", "2"),
+					resource.TestCheckResourceAttr("data.statuspal_status_summary.test", "ongoing_incidents.0.severity", "major"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,447 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	statuspal "terraform-provider-statuspal/internal/client"
+)
+
+// subscriberChannels are the channels through which a subscriber can be notified.
+var subscriberChannels = []string{"email", "sms", "webhook"}
+
+// phoneNumberRegexp matches the E.164 phone numbers of the SMS subscribers, e.g. "+14155552671".
+var phoneNumberRegexp = regexp.MustCompile(`^\+[1-9]\d{6,14}$`)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &subscriberResource{}
+	_ resource.ResourceWithConfigure      = &subscriberResource{}
+	_ resource.ResourceWithImportState    = &subscriberResource{}
+	_ resource.ResourceWithValidateConfig = &subscriberResource{}
+)
+
+// NewSubscriberResource is a helper function to simplify the provider implementation.
+func NewSubscriberResource() resource.Resource {
+	return &subscriberResource{}
+}
+
+// subscriberResource is the resource implementation.
+type subscriberResource struct {
+	client *statuspal.Client
+}
+
+// subscriberResourceModel maps the resource schema data.
+type subscriberResourceModel struct {
+	ID                  types.String    `tfsdk:"id"` // only for test case
+	StatusPageSubdomain types.String    `tfsdk:"status_page_subdomain"`
+	Subscriber          subscriberModel `tfsdk:"subscriber"`
+}
+
+// subscriberModel maps subscriber schema data.
+type subscriberModel struct {
+	ID         types.String `tfsdk:"id"`
+	Channel    types.String `tfsdk:"channel"`
+	Address    types.String `tfsdk:"address"`
+	ServiceIDs types.List   `tfsdk:"service_ids"`
+	Confirmed  types.Bool   `tfsdk:"confirmed"`
+	InsertedAt types.String `tfsdk:"inserted_at"`
+	UpdatedAt  types.String `tfsdk:"updated_at"`
+}
+
+// Metadata returns the resource type name.
+func (r *subscriberResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subscriber"
+}
+
+// Schema defines the schema for the resource.
+func (r *subscriberResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a subscriber of the status page. The subscriptions must be enabled on the status page, " +
+			"see `subscribers_enabled`. Use the `statuspal_subscribers` resource to manage many subscribers at once.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier attribute. Ignore it, only used in testing.",
+				Computed:    true,
+			},
+			"status_page_subdomain": schema.StringAttribute{
+				Description: "The status page's subdomain where the subscriber belong.",
+				Required:    true,
+			},
+			"subscriber": schema.SingleNestedAttribute{
+				Description: "The subscriber.",
+				Required:    true,
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Description: "The ID of the subscriber.",
+						Computed:    true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"channel": subscriberChannelAttribute(),
+					"address": subscriberAddressAttribute(),
+					"service_ids": schema.ListAttribute{
+						Description: "IDs of the services the subscriber is notified about. The subscriber is notified about all the services when empty.",
+						Optional:    true,
+						Computed:    true,
+						ElementType: types.StringType,
+						Default:     listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{})),
+					},
+					"confirmed": schema.BoolAttribute{
+						Description: "Whether the subscriber confirmed the subscription.",
+						Computed:    true,
+					},
+					"inserted_at": schema.StringAttribute{
+						Description: "Datetime at which the subscriber was inserted.",
+						Computed:    true,
+					},
+					"updated_at": schema.StringAttribute{
+						Description: "Datetime at which the subscriber was last updated.",
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that the address of the subscriber matches its channel.
+func (r *subscriberResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var channel, address types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("subscriber").AtName("channel"), &channel)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("subscriber").AtName("address"), &address)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if channel.IsNull() || channel.IsUnknown() || address.IsNull() || address.IsUnknown() {
+		return
+	}
+
+	if detail := subscriberAddressError(channel.ValueString(), address.ValueString()); detail != "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("subscriber").AtName("address"),
+			"Invalid StatusPal Subscriber Address",
+			detail,
+		)
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *subscriberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan subscriberResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	serviceIDs := parseServiceIDs(ctx, plan.Subscriber.ServiceIDs, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	subscriber := newSubscriber(plan.Subscriber.Channel.ValueString(), plan.Subscriber.Address.ValueString(), serviceIDs)
+
+	// Create new subscriber
+	statusPageSubdomain := plan.StatusPageSubdomain.ValueString()
+	createdSubscriber, err := r.client.CreateSubscriber(ctx, subscriber, &statusPageSubdomain)
+	if err != nil {
+		addClientError(&resp.Diagnostics, path.Root("subscriber"),
+			"Error creating StatusPal Subscriber",
+			"Could not create subscriber, unexpected error: ",
+			err,
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	newSubscriberModel := mapResponseToSubscriberModel(ctx, createdSubscriber, &plan.Subscriber, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.Subscriber = *newSubscriberModel
+	plan.ID = types.StringValue("placeholder") // only for test case
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *subscriberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state subscriberResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed subscriber value from StatusPal
+	statusPageSubdomain := state.StatusPageSubdomain.ValueString()
+	subscriberID := state.Subscriber.ID.ValueString()
+	subscriber, err := r.client.GetSubscriber(ctx, &statusPageSubdomain, &subscriberID)
+	if statuspal.ErrorNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading StatusPal Subscriber",
+			"Could not read subscriber ID "+subscriberID+": "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	subscriberModel := mapResponseToSubscriberModel(ctx, subscriber, &state.Subscriber, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Subscriber = *subscriberModel
+	state.ID = types.StringValue("placeholder") // only for test case
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *subscriberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan subscriberResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	serviceIDs := parseServiceIDs(ctx, plan.Subscriber.ServiceIDs, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	subscriber := newSubscriber(plan.Subscriber.Channel.ValueString(), plan.Subscriber.Address.ValueString(), serviceIDs)
+
+	// Update existing subscriber
+	statusPageSubdomain := plan.StatusPageSubdomain.ValueString()
+	subscriberID := plan.Subscriber.ID.ValueString()
+	updatedSubscriber, err := r.client.UpdateSubscriber(ctx, subscriber, &statusPageSubdomain, &subscriberID)
+	if err != nil {
+		addClientError(&resp.Diagnostics, path.Root("subscriber"),
+			"Error Updating StatusPal Subscriber",
+			"Could not Update subscriber, unexpected error: ",
+			err,
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	updatedSubscriberModel := mapResponseToSubscriberModel(ctx, updatedSubscriber, &plan.Subscriber, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.Subscriber = *updatedSubscriberModel
+	plan.ID = types.StringValue("placeholder") // only for test case
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *subscriberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state subscriberResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing subscriber
+	statusPageSubdomain := state.StatusPageSubdomain.ValueString()
+	subscriberID := state.Subscriber.ID.ValueString()
+	err := r.client.DeleteSubscriber(ctx, &statusPageSubdomain, &subscriberID)
+	if err != nil && !statuspal.ErrorNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting StatusPal Subscriber",
+			"Could not delete subscriber, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *subscriberResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	// Split the ID based on the delimiter used during import
+	parts := strings.Split(req.ID, " ")
+	if len(parts) != 2 {
+		resp.Diagnostics.AddError(
+			"Unexpected StatusPal Subscriber Import Identifier",
+			`Expected StatusPal subscriber import identifier with format: "<status_page_subdomain> <subscriber_id>"`,
+		)
+		return
+	}
+
+	req.ID = parts[0]
+	resource.ImportStatePassthroughID(ctx, path.Root("status_page_subdomain"), req, resp)
+	req.ID = parts[1]
+	resource.ImportStatePassthroughID(ctx, path.Root("subscriber").AtName("id"), req, resp)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *subscriberResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*statuspal.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf(
+				"Expected *statuspal.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// subscriberChannelAttribute returns the schema of the channel of a subscriber.
+func subscriberChannelAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: "Enum: `\"email\"` `\"sms\"` `\"webhook\"`\n  The channel through which the subscriber is notified.",
+		Required:            true,
+		Validators: []validator.String{
+			stringvalidator.OneOf(subscriberChannels...),
+		},
+	}
+}
+
+// subscriberAddressAttribute returns the schema of the address of a subscriber.
+func subscriberAddressAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: "The address the subscriber is notified at: an email address for the `email` channel, " +
+			"an E.164 phone number (e.g. `+14155552671`) for the `sms` channel, or an HTTP(S) URL for the `webhook` channel.",
+		Required: true,
+	}
+}
+
+// subscriberAddressError returns the detail of the error when the address is not valid for the channel, or an
+// empty string.
+func subscriberAddressError(channel, address string) string {
+	switch channel {
+	case "email":
+		if _, err := mail.ParseAddress(address); err != nil {
+			return fmt.Sprintf("The address %q of the email subscriber must be an email address: %s.", address, err)
+		}
+	case "sms":
+		if !phoneNumberRegexp.MatchString(address) {
+			return fmt.Sprintf("The address %q of the SMS subscriber must be an E.164 phone number, e.g. +14155552671.", address)
+		}
+	case "webhook":
+		parsedURL, err := url.Parse(address)
+		if err != nil || (parsedURL.Scheme != "http" && parsedURL.Scheme != "https") || parsedURL.Host == "" {
+			return fmt.Sprintf("The address %q of the webhook subscriber must be an http or https URL.", address)
+		}
+	}
+
+	return ""
+}
+
+// newSubscriber returns the subscriber notified through the channel at the address.
+func newSubscriber(channel, address string, serviceIDs []int64) *statuspal.Subscriber {
+	subscriber := &statuspal.Subscriber{
+		Type:       channel,
+		ServiceIDs: serviceIDs,
+	}
+
+	switch channel {
+	case "email":
+		subscriber.Email = address
+	case "sms":
+		subscriber.PhoneNumber = address
+	case "webhook":
+		subscriber.Url = address
+	}
+
+	return subscriber
+}
+
+// subscriberAddress returns the address at which the subscriber is notified through its channel.
+func subscriberAddress(subscriber *statuspal.Subscriber) string {
+	switch subscriber.Type {
+	case "sms":
+		return subscriber.PhoneNumber
+	case "webhook":
+		return subscriber.Url
+	default:
+		return subscriber.Email
+	}
+}
+
+func mapResponseToSubscriberModel(
+	ctx context.Context,
+	subscriber *statuspal.Subscriber,
+	previous *subscriberModel,
+	diagnostics *diag.Diagnostics,
+) *subscriberModel {
+	serviceIDs := mapServiceIDsToList(ctx, previous.ServiceIDs, subscriber.ServiceIDs, diagnostics)
+	if diagnostics.HasError() {
+		return nil
+	}
+
+	return &subscriberModel{
+		ID:         types.StringValue(strconv.FormatInt(subscriber.ID, 10)),
+		Channel:    types.StringValue(subscriber.Type),
+		Address:    types.StringValue(subscriberAddress(subscriber)),
+		ServiceIDs: serviceIDs,
+		Confirmed:  types.BoolValue(subscriber.Confirmed),
+		InsertedAt: types.StringValue(subscriber.InsertedAt),
+		UpdatedAt:  types.StringValue(subscriber.UpdatedAt),
+	}
+}
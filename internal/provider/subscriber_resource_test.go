@@ -0,0 +1,144 @@
+package provider
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccSubscriberResource(t *testing.T) {
+	responseBody := `{
+		"subscriber": {
+			"id": 1,
+			"type": "email",
+			"email": "jane@example.com",
+			"service_ids": [2, 1],
+			"confirmed": false,
+			"inserted_at": "2024-05-16T10:00:00",
+			"updated_at": "2024-05-16T10:00:00"
+		}
+	}`
+	updatedResponseBody := `{
+		"subscriber": {
+			"id": 1,
+			"type": "webhook",
+			"url": "https://hooks.example.com/statuspal",
+			"service_ids": [],
+			"confirmed": false,
+			"inserted_at": "2024-05-16T10:00:00",
+			"updated_at": "2024-05-16T11:00:00"
+		}
+	}`
+
+	var updated atomic.Bool
+	var deleted atomic.Int32
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /status_pages/example-com/subscribers", func(w http.ResponseWriter, r *http.Request) {
+		if _, err := w.Write([]byte(responseBody)); err != nil {
+			log.Printf(`Error writing "/status_pages/example-com/subscribers" response: %v`, err)
+		}
+	})
+	mux.HandleFunc("/status_pages/example-com/subscribers/1", func(w http.ResponseWriter, r *http.Request) {
+		body := responseBody
+		if updated.Load() {
+			body = updatedResponseBody
+		}
+
+		switch r.Method {
+		case http.MethodPut:
+			updated.Store(true)
+			body = updatedResponseBody
+		case http.MethodDelete:
+			deleted.Add(1)
+			body = `""`
+		}
+
+		if _, err := w.Write([]byte(body)); err != nil {
+			log.Printf(`Error writing "/status_pages/example-com/subscribers/1" response with method "%s": %v`, r.Method, err)
+		}
+	})
+	mock := httptest.NewServer(mux)
+	defer mock.Close()
+
+	providerConfig := *providerConfig(&mock.URL)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Invalid address error testing
+			{
+				Config: providerConfig + `
+resource "statuspal_subscriber" "test" {
+  status_page_subdomain = "example-com"
+  subscriber = {
+    channel = "sms"
+    address = "jane@example.com"
+  }
+}
+`,
+				ExpectError: regexp.MustCompile(`must be an E.164 phone number`),
+			},
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "statuspal_subscriber" "test" {
+  status_page_subdomain = "example-com"
+  subscriber = {
+    channel     = "email"
+    address     = "jane@example.com"
+    service_ids = ["2", "1"]
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("statuspal_subscriber.test", "status_page_subdomain", "example-com"),
+					resource.TestCheckResourceAttr("statuspal_subscriber.test", "subscriber.id", "1"),
+					resource.TestCheckResourceAttr("statuspal_subscriber.test", "subscriber.channel", "email"),
+					resource.TestCheckResourceAttr("statuspal_subscriber.test", "subscriber.address", "jane@example.com"),
+					resource.TestCheckResourceAttr("statuspal_subscriber.test", "subscriber.service_ids.#", "2"),
+					resource.TestCheckResourceAttr("statuspal_subscriber.test", "subscriber.service_ids.0", "2"),
+					resource.TestCheckResourceAttr("statuspal_subscriber.test", "subscriber.confirmed", "false"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "statuspal_subscriber.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     "example-com 1",
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "statuspal_subscriber" "test" {
+  status_page_subdomain = "example-com"
+  subscriber = {
+    channel = "webhook"
+    address = "https://hooks.example.com/statuspal"
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("statuspal_subscriber.test", "subscriber.id", "1"),
+					resource.TestCheckResourceAttr("statuspal_subscriber.test", "subscriber.channel", "webhook"),
+					resource.TestCheckResourceAttr("statuspal_subscriber.test", "subscriber.address", "https://hooks.example.com/statuspal"),
+					resource.TestCheckResourceAttr("statuspal_subscriber.test", "subscriber.service_ids.#", "0"),
+					resource.TestCheckResourceAttr("statuspal_subscriber.test", "subscriber.updated_at", "2024-05-16T11:00:00"),
+				),
+			},
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			if deleted.Load() != 1 {
+				return fmt.Errorf("expected the subscriber to be deleted")
+			}
+			return nil
+		},
+	})
+}
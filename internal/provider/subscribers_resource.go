@@ -0,0 +1,584 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	statuspal "terraform-provider-statuspal/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &subscribersResource{}
+	_ resource.ResourceWithConfigure      = &subscribersResource{}
+	_ resource.ResourceWithValidateConfig = &subscribersResource{}
+)
+
+// NewSubscribersResource is a helper function to simplify the provider implementation.
+func NewSubscribersResource() resource.Resource {
+	return &subscribersResource{}
+}
+
+// subscribersResource is the resource implementation.
+type subscribersResource struct {
+	client *statuspal.Client
+}
+
+// subscribersResourceModel maps the resource schema data.
+type subscribersResourceModel struct {
+	ID                  types.String `tfsdk:"id"` // only for test case
+	StatusPageSubdomain types.String `tfsdk:"status_page_subdomain"`
+	Subscribers         types.Set    `tfsdk:"subscribers"`
+	SubscriberIDs       types.Map    `tfsdk:"subscriber_ids"`
+}
+
+// subscribersItemModel maps the schema data of an item of the subscribers set.
+type subscribersItemModel struct {
+	Channel    types.String `tfsdk:"channel"`
+	Address    types.String `tfsdk:"address"`
+	ServiceIDs types.Set    `tfsdk:"service_ids"`
+}
+
+// subscribersItemAttrTypes is the type of the items of the subscribers set.
+var subscribersItemAttrTypes = map[string]attr.Type{
+	"channel":     types.StringType,
+	"address":     types.StringType,
+	"service_ids": types.SetType{ElemType: types.StringType},
+}
+
+// managedSubscriber is a subscriber managed by the resource, keyed by its channel and address.
+type managedSubscriber struct {
+	ID         int64
+	Channel    string
+	Address    string
+	ServiceIDs types.Set
+	serviceIDs []int64
+}
+
+// key identifies the subscriber in the set, e.g. "email:jane@example.com".
+func (s managedSubscriber) key() string {
+	return s.Channel + ":" + s.Address
+}
+
+// sameServices reports whether both subscribers are notified about the same services.
+func (s managedSubscriber) sameServices(other managedSubscriber) bool {
+	return fmt.Sprint(s.serviceIDs) == fmt.Sprint(other.serviceIDs)
+}
+
+// Metadata returns the resource type name.
+func (r *subscribersResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subscribers"
+}
+
+// Schema defines the schema for the resource.
+func (r *subscribersResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a set of subscribers of the status page, e.g. to on-board a whole mailing list. " +
+			"The subscribers are created and deleted in batches of " + strconv.Itoa(statuspal.SubscribersBatchSize) +
+			" per API call, within the rate limit of the provider. Only the subscribers of the set are managed, " +
+			"the other subscribers of the status page are left untouched.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier attribute. Ignore it, only used in testing.",
+				Computed:    true,
+			},
+			"status_page_subdomain": schema.StringAttribute{
+				Description: "The status page's subdomain where the subscribers belong.",
+				Required:    true,
+			},
+			"subscribers": schema.SetNestedAttribute{
+				Description: "The subscribers, each channel and address pair must be unique.",
+				Required:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"channel": subscriberChannelAttribute(),
+						"address": subscriberAddressAttribute(),
+						"service_ids": schema.SetAttribute{
+							Description: "IDs of the services the subscriber is notified about. The subscriber is notified about all the services when empty.",
+							Optional:    true,
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+			"subscriber_ids": schema.MapAttribute{
+				MarkdownDescription: "The IDs of the subscribers, keyed by their channel and address, e.g. `email:jane@example.com`.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+// ValidateConfig checks the address of each subscriber and that no subscriber is duplicated.
+func (r *subscribersResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var subscribers types.Set
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("subscribers"), &subscribers)...)
+	if resp.Diagnostics.HasError() || subscribers.IsNull() || subscribers.IsUnknown() {
+		return
+	}
+
+	var items []subscribersItemModel
+	resp.Diagnostics.Append(subscribers.ElementsAs(ctx, &items, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	keys := map[string]bool{}
+	for _, item := range items {
+		if item.Channel.IsUnknown() || item.Address.IsUnknown() {
+			continue
+		}
+
+		if detail := subscriberAddressError(item.Channel.ValueString(), item.Address.ValueString()); detail != "" {
+			resp.Diagnostics.AddAttributeError(path.Root("subscribers"), "Invalid StatusPal Subscriber Address", detail)
+		}
+
+		key := item.Channel.ValueString() + ":" + item.Address.ValueString()
+		if keys[key] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("subscribers"),
+				"Duplicate StatusPal Subscriber",
+				fmt.Sprintf("The subscriber %q is declared more than once, merge its service_ids instead.", key),
+			)
+		}
+		keys[key] = true
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *subscribersResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan subscribersResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	desired := parseManagedSubscribers(ctx, plan.Subscribers, types.MapNull(types.StringType), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create the subscribers, the ones created before an error are still saved to the state
+	statusPageSubdomain := plan.StatusPageSubdomain.ValueString()
+	managed := r.reconcile(ctx, statusPageSubdomain, map[string]managedSubscriber{}, desired, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(setManagedSubscribersState(ctx, &resp.State, statusPageSubdomain, managed)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *subscribersResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state subscribersResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	managed := parseManagedSubscribers(ctx, state.Subscribers, state.SubscriberIDs, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed subscribers from StatusPal
+	statusPageSubdomain := state.StatusPageSubdomain.ValueString()
+	subscribers, err := r.client.GetSubscribers(ctx, &statusPageSubdomain)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading StatusPal Subscribers",
+			"Could not read the subscribers of the status page "+statusPageSubdomain+": "+err.Error(),
+		)
+		return
+	}
+
+	subscribersByID := make(map[int64]statuspal.Subscriber, len(*subscribers))
+	for _, subscriber := range *subscribers {
+		subscribersByID[subscriber.ID] = subscriber
+	}
+
+	// The subscribers deleted outside of Terraform are dropped, to be created again
+	refreshed := make(map[string]managedSubscriber, len(managed))
+	for _, previous := range managed {
+		subscriber, ok := subscribersByID[previous.ID]
+		if !ok {
+			continue
+		}
+
+		current := mapResponseToManagedSubscriber(ctx, &subscriber, previous, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		refreshed[current.key()] = current
+	}
+
+	resp.Diagnostics.Append(setManagedSubscribersState(ctx, &resp.State, statusPageSubdomain, refreshed)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *subscribersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan and state
+	var plan, state subscribersResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	desired := parseManagedSubscribers(ctx, plan.Subscribers, types.MapNull(types.StringType), &resp.Diagnostics)
+	managed := parseManagedSubscribers(ctx, state.Subscribers, state.SubscriberIDs, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Reconcile the subscribers, the changes applied before an error are still saved to the state
+	statusPageSubdomain := plan.StatusPageSubdomain.ValueString()
+	managed = r.reconcile(ctx, statusPageSubdomain, managed, desired, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(setManagedSubscribersState(ctx, &resp.State, statusPageSubdomain, managed)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *subscribersResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state subscribersResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	managed := parseManagedSubscribers(ctx, state.Subscribers, state.SubscriberIDs, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete every managed subscriber, the ones left after an error are kept in the state
+	statusPageSubdomain := state.StatusPageSubdomain.ValueString()
+	managed = r.reconcile(ctx, statusPageSubdomain, managed, map[string]managedSubscriber{}, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(setManagedSubscribersState(ctx, &resp.State, statusPageSubdomain, managed)...)
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *subscribersResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*statuspal.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf(
+				"Expected *statuspal.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// reconcile deletes, updates and creates the subscribers of the status page so the managed subscribers match the
+// desired ones. The deletions and creations are sent in batches. It returns the managed subscribers, including the
+// changes applied before an error.
+func (r *subscribersResource) reconcile(
+	ctx context.Context,
+	statusPageSubdomain string,
+	managed map[string]managedSubscriber,
+	desired map[string]managedSubscriber,
+	diagnostics *diag.Diagnostics,
+) map[string]managedSubscriber {
+	// Delete the subscribers removed from the set
+	var deletedIDs []int64
+	for _, key := range sortedKeys(managed) {
+		if _, ok := desired[key]; !ok {
+			deletedIDs = append(deletedIDs, managed[key].ID)
+		}
+	}
+	if len(deletedIDs) > 0 {
+		deleted, err := r.client.DeleteSubscribers(ctx, &statusPageSubdomain, deletedIDs)
+		if err != nil && statuspal.ErrorNotFound(err) {
+			// A batch holding a subscriber deleted outside of Terraform is deleted one by one
+			deleted, err = r.deleteSubscribers(ctx, statusPageSubdomain, deletedIDs)
+		}
+
+		removeManagedSubscribers(managed, deleted)
+		if err != nil {
+			addClientError(diagnostics, path.Root("subscribers"),
+				"Error Deleting StatusPal Subscribers",
+				fmt.Sprintf("Could not delete %d of the %d subscribers, unexpected error: ", len(deletedIDs)-len(deleted), len(deletedIDs)),
+				err,
+			)
+			return managed
+		}
+	}
+
+	// Update the services of the subscribers which changed
+	var toCreate []statuspal.Subscriber
+	for _, key := range sortedKeys(desired) {
+		subscriber := desired[key]
+
+		current, ok := managed[key]
+		if !ok {
+			toCreate = append(toCreate, *newSubscriber(subscriber.Channel, subscriber.Address, subscriber.serviceIDs))
+			continue
+		}
+
+		if current.sameServices(subscriber) {
+			// Keep the configured value, e.g. a null set instead of an empty one
+			current.ServiceIDs = subscriber.ServiceIDs
+			managed[key] = current
+			continue
+		}
+
+		subscriberID := strconv.FormatInt(current.ID, 10)
+		_, err := r.client.UpdateSubscriber(ctx, newSubscriber(subscriber.Channel, subscriber.Address, subscriber.serviceIDs), &statusPageSubdomain, &subscriberID)
+		if err != nil {
+			addClientError(diagnostics, path.Root("subscribers"),
+				"Error Updating StatusPal Subscribers",
+				fmt.Sprintf("Could not update the subscriber %q, unexpected error: ", key),
+				err,
+			)
+			return managed
+		}
+
+		subscriber.ID = current.ID
+		managed[key] = subscriber
+	}
+
+	// Create the subscribers added to the set
+	if len(toCreate) > 0 {
+		createdSubscribers, err := r.client.CreateSubscribers(ctx, toCreate, &statusPageSubdomain)
+		for i, createdSubscriber := range createdSubscribers {
+			key := createdSubscriber.Type + ":" + subscriberAddress(&createdSubscriber)
+			if _, ok := desired[key]; !ok {
+				// The API didn't echo the address, the subscribers are returned in the order they were sent
+				key = toCreate[i].Type + ":" + subscriberAddress(&toCreate[i])
+			}
+
+			subscriber := desired[key]
+			subscriber.ID = createdSubscriber.ID
+			managed[key] = subscriber
+		}
+
+		if err != nil {
+			addClientError(diagnostics, path.Root("subscribers"),
+				"Error creating StatusPal Subscribers",
+				fmt.Sprintf("Could not create %d of the %d subscribers, unexpected error: ", len(toCreate)-len(createdSubscribers), len(toCreate)),
+				err,
+			)
+			return managed
+		}
+	}
+
+	return managed
+}
+
+// deleteSubscribers deletes the subscribers one by one, ignoring the ones already deleted. It returns the IDs of the
+// subscribers deleted before an error.
+func (r *subscribersResource) deleteSubscribers(ctx context.Context, statusPageSubdomain string, subscriberIDs []int64) ([]int64, error) {
+	deleted := make([]int64, 0, len(subscriberIDs))
+	for _, id := range subscriberIDs {
+		subscriberID := strconv.FormatInt(id, 10)
+		if err := r.client.DeleteSubscriber(ctx, &statusPageSubdomain, &subscriberID); err != nil && !statuspal.ErrorNotFound(err) {
+			return deleted, err
+		}
+
+		deleted = append(deleted, id)
+	}
+
+	return deleted, nil
+}
+
+// setManagedSubscribersState sets the managed subscribers to the state. It is called even when the reconciliation
+// failed, to save the changes applied before the error.
+func setManagedSubscribersState(
+	ctx context.Context,
+	state *tfsdk.State,
+	statusPageSubdomain string,
+	managed map[string]managedSubscriber,
+) diag.Diagnostics {
+	var diagnostics diag.Diagnostics
+
+	items := make([]attr.Value, 0, len(managed))
+	ids := make(map[string]attr.Value, len(managed))
+	for _, key := range sortedKeys(managed) {
+		subscriber := managed[key]
+
+		item, diags := types.ObjectValue(subscribersItemAttrTypes, map[string]attr.Value{
+			"channel":     types.StringValue(subscriber.Channel),
+			"address":     types.StringValue(subscriber.Address),
+			"service_ids": subscriber.ServiceIDs,
+		})
+		diagnostics.Append(diags...)
+		if diagnostics.HasError() {
+			return diagnostics
+		}
+
+		items = append(items, item)
+		ids[key] = types.StringValue(strconv.FormatInt(subscriber.ID, 10))
+	}
+
+	subscribers, diags := types.SetValue(types.ObjectType{AttrTypes: subscribersItemAttrTypes}, items)
+	diagnostics.Append(diags...)
+	subscriberIDs, diags := types.MapValue(types.StringType, ids)
+	diagnostics.Append(diags...)
+	if diagnostics.HasError() {
+		return diagnostics
+	}
+
+	diagnostics.Append(state.Set(ctx, subscribersResourceModel{
+		ID:                  types.StringValue("placeholder"), // only for test case
+		StatusPageSubdomain: types.StringValue(statusPageSubdomain),
+		Subscribers:         subscribers,
+		SubscriberIDs:       subscriberIDs,
+	})...)
+
+	return diagnostics
+}
+
+// parseManagedSubscribers returns the subscribers of the set keyed by their channel and address, with their ID
+// when known.
+func parseManagedSubscribers(ctx context.Context, set types.Set, ids types.Map, diagnostics *diag.Diagnostics) map[string]managedSubscriber {
+	managed := map[string]managedSubscriber{}
+	if set.IsNull() || set.IsUnknown() {
+		return managed
+	}
+
+	var items []subscribersItemModel
+	diagnostics.Append(set.ElementsAs(ctx, &items, false)...)
+
+	subscriberIDs := map[string]string{}
+	if !ids.IsNull() && !ids.IsUnknown() {
+		diagnostics.Append(ids.ElementsAs(ctx, &subscriberIDs, false)...)
+	}
+	if diagnostics.HasError() {
+		return nil
+	}
+
+	for _, item := range items {
+		var serviceIDs []string
+		if !item.ServiceIDs.IsNull() && !item.ServiceIDs.IsUnknown() {
+			diagnostics.Append(item.ServiceIDs.ElementsAs(ctx, &serviceIDs, false)...)
+			if diagnostics.HasError() {
+				return nil
+			}
+		}
+
+		subscriber := managedSubscriber{
+			Channel:    item.Channel.ValueString(),
+			Address:    item.Address.ValueString(),
+			ServiceIDs: item.ServiceIDs,
+			serviceIDs: []int64{},
+		}
+		for _, value := range serviceIDs {
+			serviceID, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				diagnostics.AddError("Not valid service ID", err.Error())
+
+				return nil
+			}
+
+			subscriber.serviceIDs = append(subscriber.serviceIDs, serviceID)
+		}
+		sort.Slice(subscriber.serviceIDs, func(i, j int) bool { return subscriber.serviceIDs[i] < subscriber.serviceIDs[j] })
+
+		if id, ok := subscriberIDs[subscriber.key()]; ok {
+			parsedID, err := strconv.ParseInt(id, 10, 64)
+			if err != nil {
+				diagnostics.AddError("Not valid subscriber ID", err.Error())
+
+				return nil
+			}
+
+			subscriber.ID = parsedID
+		}
+
+		managed[subscriber.key()] = subscriber
+	}
+
+	return managed
+}
+
+// mapResponseToManagedSubscriber maps the subscriber returned by the API, keeping the previous services value
+// when the subscriber is still notified about the same services.
+func mapResponseToManagedSubscriber(
+	ctx context.Context,
+	subscriber *statuspal.Subscriber,
+	previous managedSubscriber,
+	diagnostics *diag.Diagnostics,
+) managedSubscriber {
+	serviceIDs := append([]int64{}, subscriber.ServiceIDs...)
+	sort.Slice(serviceIDs, func(i, j int) bool { return serviceIDs[i] < serviceIDs[j] })
+
+	current := managedSubscriber{
+		ID:         subscriber.ID,
+		Channel:    subscriber.Type,
+		Address:    subscriberAddress(subscriber),
+		ServiceIDs: previous.ServiceIDs,
+		serviceIDs: serviceIDs,
+	}
+	if current.sameServices(previous) {
+		return current
+	}
+
+	values := make([]string, 0, len(serviceIDs))
+	for _, serviceID := range serviceIDs {
+		values = append(values, strconv.FormatInt(serviceID, 10))
+	}
+
+	set, diags := types.SetValueFrom(ctx, types.StringType, values)
+	diagnostics.Append(diags...)
+	current.ServiceIDs = set
+
+	return current
+}
+
+// removeManagedSubscribers removes the subscribers with the given IDs.
+func removeManagedSubscribers(managed map[string]managedSubscriber, subscriberIDs []int64) {
+	removed := make(map[int64]bool, len(subscriberIDs))
+	for _, id := range subscriberIDs {
+		removed[id] = true
+	}
+
+	for key, subscriber := range managed {
+		if removed[subscriber.ID] {
+			delete(managed, key)
+		}
+	}
+}
+
+// sortedKeys returns the keys of the subscribers in order, so they are sent in a stable order.
+func sortedKeys(subscribers map[string]managedSubscriber) []string {
+	keys := make([]string, 0, len(subscribers))
+	for key := range subscribers {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,218 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	statuspal "terraform-provider-statuspal/internal/client"
+)
+
+func TestAccSubscribersResource(t *testing.T) {
+	// The subscribers are kept in memory, the batches of the resource are too large for literal responses
+	var mutex sync.Mutex
+	var lastID int64
+	subscribers := map[int64]statuspal.Subscriber{}
+	requests := map[string]int{}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /status_pages/example-com/subscribers", func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		list := []statuspal.Subscriber{}
+		for _, subscriber := range subscribers {
+			list = append(list, subscriber)
+		}
+		sort.Slice(list, func(i, j int) bool { return list[i].ID < list[j].ID })
+
+		body, err := json.Marshal(map[string]any{"subscribers": list, "links": map[string]any{"next": nil}})
+		if err != nil {
+			http.Error(w, fmt.Sprintf("Failed to encode JSON: %v", err), http.StatusInternalServerError)
+			return
+		}
+
+		if _, err := w.Write(body); err != nil {
+			log.Printf(`Error writing "/status_pages/example-com/subscribers" response: %v`, err)
+		}
+	})
+	mux.HandleFunc("POST /status_pages/example-com/subscribers/bulk", func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		requests["bulk"]++
+
+		var request struct {
+			Subscribers []statuspal.Subscriber `json:"subscribers"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			http.Error(w, fmt.Sprintf("Failed to decode JSON: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		for i := range request.Subscribers {
+			lastID++
+			request.Subscribers[i].ID = lastID
+			subscribers[lastID] = request.Subscribers[i]
+		}
+
+		body, err := json.Marshal(request)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("Failed to encode JSON: %v", err), http.StatusInternalServerError)
+			return
+		}
+
+		if _, err := w.Write(body); err != nil {
+			log.Printf(`Error writing "/status_pages/example-com/subscribers/bulk" response: %v`, err)
+		}
+	})
+	mux.HandleFunc("POST /status_pages/example-com/subscribers/bulk_delete", func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		requests["bulk_delete"]++
+
+		var request struct {
+			IDs []int64 `json:"ids"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			http.Error(w, fmt.Sprintf("Failed to decode JSON: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		for _, id := range request.IDs {
+			delete(subscribers, id)
+		}
+
+		if _, err := w.Write([]byte(`""`)); err != nil {
+			log.Printf(`Error writing "/status_pages/example-com/subscribers/bulk_delete" response: %v`, err)
+		}
+	})
+	mux.HandleFunc("/status_pages/example-com/subscribers/{id}", func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		requests[r.Method]++
+
+		var id int64
+		if _, err := fmt.Sscan(r.PathValue("id"), &id); err != nil {
+			http.Error(w, fmt.Sprintf("Invalid subscriber ID: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		switch r.Method {
+		case http.MethodPut:
+			var request statuspal.SubscriberResponse
+			if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+				http.Error(w, fmt.Sprintf("Failed to decode JSON: %v", err), http.StatusBadRequest)
+				return
+			}
+
+			request.Subscriber.ID = id
+			subscribers[id] = request.Subscriber
+
+			body, err := json.Marshal(request)
+			if err != nil {
+				http.Error(w, fmt.Sprintf("Failed to encode JSON: %v", err), http.StatusInternalServerError)
+				return
+			}
+
+			if _, err := w.Write(body); err != nil {
+				log.Printf(`Error writing "/status_pages/example-com/subscribers/%d" response: %v`, id, err)
+			}
+		case http.MethodDelete:
+			delete(subscribers, id)
+
+			if _, err := w.Write([]byte(`""`)); err != nil {
+				log.Printf(`Error writing "/status_pages/example-com/subscribers/%d" response: %v`, id, err)
+			}
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	})
+	mock := httptest.NewServer(mux)
+	defer mock.Close()
+
+	providerConfig := *providerConfig(&mock.URL)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Duplicate subscriber error testing
+			{
+				Config: providerConfig + `
+resource "statuspal_subscribers" "test" {
+  status_page_subdomain = "example-com"
+  subscribers = [
+    { channel = "email", address = "jane@example.com", service_ids = ["1"] },
+    { channel = "email", address = "jane@example.com", service_ids = ["2"] },
+  ]
+}
+`,
+				ExpectError: regexp.MustCompile(`declared more than once`),
+			},
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "statuspal_subscribers" "test" {
+  status_page_subdomain = "example-com"
+  subscribers = concat(
+    [for i in range(150) : { channel = "email", address = "user-${i}@example.com", service_ids = null }],
+    [{ channel = "sms", address = "+14155552671", service_ids = ["1"] }],
+  )
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("statuspal_subscribers.test", "subscribers.#", "151"),
+					resource.TestCheckResourceAttr("statuspal_subscribers.test", "subscriber_ids.%", "151"),
+					resource.TestCheckResourceAttrSet("statuspal_subscribers.test", "subscriber_ids.email:user-0@example.com"),
+					resource.TestCheckResourceAttrSet("statuspal_subscribers.test", "subscriber_ids.sms:+14155552671"),
+					func(_ *terraform.State) error {
+						if requests["bulk"] != 2 {
+							return fmt.Errorf("expected the subscribers to be created in 2 batches, got: %v", requests)
+						}
+						return nil
+					},
+				),
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "statuspal_subscribers" "test" {
+  status_page_subdomain = "example-com"
+  subscribers = concat(
+    [for i in range(100) : { channel = "email", address = "user-${i}@example.com", service_ids = null }],
+    [
+      { channel = "sms", address = "+14155552671", service_ids = ["1", "2"] },
+      { channel = "webhook", address = "https://hooks.example.com/statuspal", service_ids = null },
+    ],
+  )
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("statuspal_subscribers.test", "subscribers.#", "102"),
+					resource.TestCheckResourceAttr("statuspal_subscribers.test", "subscriber_ids.%", "102"),
+					resource.TestCheckNoResourceAttr("statuspal_subscribers.test", "subscriber_ids.email:user-100@example.com"),
+					resource.TestCheckResourceAttrSet("statuspal_subscribers.test", "subscriber_ids.webhook:https://hooks.example.com/statuspal"),
+					func(_ *terraform.State) error {
+						if requests["bulk_delete"] != 1 || requests[http.MethodPut] != 1 || requests["bulk"] != 3 {
+							return fmt.Errorf("expected 50 subscribers to be deleted in a batch, 1 to be updated and 1 to be created, got: %v", requests)
+						}
+						return nil
+					},
+				),
+			},
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			if requests["bulk_delete"] != 3 || requests[http.MethodDelete] != 0 {
+				return fmt.Errorf("expected the 102 subscribers to be deleted in 2 batches, got: %v", requests)
+			}
+			return nil
+		},
+	})
+}
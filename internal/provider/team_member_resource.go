@@ -0,0 +1,364 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	statuspal "terraform-provider-statuspal/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &teamMemberResource{}
+	_ resource.ResourceWithConfigure      = &teamMemberResource{}
+	_ resource.ResourceWithImportState    = &teamMemberResource{}
+	_ resource.ResourceWithValidateConfig = &teamMemberResource{}
+)
+
+// NewTeamMemberResource is a helper function to simplify the provider implementation.
+func NewTeamMemberResource() resource.Resource {
+	return &teamMemberResource{}
+}
+
+// teamMemberResource is the resource implementation.
+type teamMemberResource struct {
+	client *statuspal.Client
+}
+
+// teamMemberResourceModel maps the resource schema data.
+type teamMemberResourceModel struct {
+	ID             types.String    `tfsdk:"id"` // only for test case
+	OrganizationID types.String    `tfsdk:"organization_id"`
+	TeamMember     teamMemberModel `tfsdk:"team_member"`
+}
+
+// teamMemberModel maps team member schema data.
+type teamMemberModel struct {
+	ID                   types.String `tfsdk:"id"`
+	Email                types.String `tfsdk:"email"`
+	Role                 types.String `tfsdk:"role"`
+	Name                 types.String `tfsdk:"name"`
+	State                types.String `tfsdk:"state"`
+	InvitationSentAt     types.String `tfsdk:"invitation_sent_at"`
+	InvitationAcceptedAt types.String `tfsdk:"invitation_accepted_at"`
+	InsertedAt           types.String `tfsdk:"inserted_at"`
+	UpdatedAt            types.String `tfsdk:"updated_at"`
+}
+
+// Metadata returns the resource type name.
+func (r *teamMemberResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_member"
+}
+
+// Schema defines the schema for the resource.
+func (r *teamMemberResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a member of the organization. The user is invited by email, and removed from the organization on destroy.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier attribute. Ignore it, only used in testing.",
+				Computed:    true,
+			},
+			"organization_id": schema.StringAttribute{
+				Description: "The organization ID of the team member.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"team_member": schema.SingleNestedAttribute{
+				Description: "The team member.",
+				Required:    true,
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Description: "The ID of the team member.",
+						Computed:    true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"email": schema.StringAttribute{
+						Description: "The email address the invitation is sent to. Changing it invites a new team member.",
+						Required:    true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+					"role": schema.StringAttribute{
+						MarkdownDescription: "Enum: `\"admin\"` `\"member\"`\n  The role of the team member in the organization:\n" +
+							"  - `admin` - Manages the organization, its status pages and its team.\n" +
+							"  - `member` - Manages the status pages of the organization.",
+						Required: true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("admin", "member"),
+						},
+					},
+					"name": schema.StringAttribute{
+						Description: "The name of the user, once the invitation is accepted.",
+						Computed:    true,
+					},
+					"state": schema.StringAttribute{
+						MarkdownDescription: "The state of the membership: `invited` until the user accepts the invitation, then `active`.",
+						Computed:            true,
+					},
+					"invitation_sent_at": schema.StringAttribute{
+						Description: "Datetime at which the invitation was sent.",
+						Computed:    true,
+					},
+					"invitation_accepted_at": schema.StringAttribute{
+						Description: "Datetime at which the invitation was accepted.",
+						Computed:    true,
+					},
+					"inserted_at": schema.StringAttribute{
+						Description: "Datetime at which the team member was inserted.",
+						Computed:    true,
+					},
+					"updated_at": schema.StringAttribute{
+						Description: "Datetime at which the team member was last updated.",
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig checks the email address of the team member.
+func (r *teamMemberResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var email types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("team_member").AtName("email"), &email)...)
+	if resp.Diagnostics.HasError() || email.IsNull() || email.IsUnknown() {
+		return
+	}
+
+	if detail := subscriberAddressError("email", email.ValueString()); detail != "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("team_member").AtName("email"),
+			"Invalid StatusPal Team Member Email",
+			detail,
+		)
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *teamMemberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan teamMemberResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	member := mapTeamMemberModelToRequestBody(&plan.TeamMember)
+
+	// Invite new team member
+	organizationID := plan.OrganizationID.ValueString()
+	newMember, err := r.client.CreateTeamMember(ctx, member, &organizationID)
+	if err != nil {
+		addClientError(&resp.Diagnostics, path.Root("team_member"),
+			"Error creating StatusPal Team Member",
+			"Could not create team member, unexpected error: ",
+			err,
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.TeamMember = *mapResponseToTeamMemberModel(newMember)
+	plan.ID = types.StringValue("placeholder") // only for test case
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *teamMemberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state teamMemberResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed team member value from StatusPal
+	organizationID := state.OrganizationID.ValueString()
+	memberID := state.TeamMember.ID.ValueString()
+	member, err := r.client.GetTeamMember(ctx, &organizationID, &memberID)
+	if statuspal.ErrorNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading StatusPal Team Member",
+			"Could not read team member ID "+memberID+": "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	state.TeamMember = *mapResponseToTeamMemberModel(member)
+	state.ID = types.StringValue("placeholder") // only for test case
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *teamMemberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan teamMemberResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	member := mapTeamMemberModelToRequestBody(&plan.TeamMember)
+
+	// Update existing team member
+	organizationID := plan.OrganizationID.ValueString()
+	memberID := plan.TeamMember.ID.ValueString()
+	updatedMember, err := r.client.UpdateTeamMember(ctx, member, &organizationID, &memberID)
+	if err != nil {
+		addClientError(&resp.Diagnostics, path.Root("team_member"),
+			"Error Updating StatusPal Team Member",
+			"Could not Update team member, unexpected error: ",
+			err,
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.TeamMember = *mapResponseToTeamMemberModel(updatedMember)
+	plan.ID = types.StringValue("placeholder") // only for test case
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *teamMemberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state teamMemberResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Remove the team member from the organization, or revoke its pending invitation
+	organizationID := state.OrganizationID.ValueString()
+	memberID := state.TeamMember.ID.ValueString()
+	err := r.client.DeleteTeamMember(ctx, &organizationID, &memberID)
+	if err != nil && !statuspal.ErrorNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting StatusPal Team Member",
+			"Could not delete team member, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *teamMemberResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	// Split the ID based on the delimiter used during import
+	parts := strings.Split(req.ID, " ")
+	if len(parts) != 2 {
+		resp.Diagnostics.AddError(
+			"Unexpected StatusPal Team Member Import Identifier",
+			`Expected StatusPal team member import identifier with format: "<organization_id> <team_member_id>"`,
+		)
+		return
+	}
+
+	req.ID = parts[0]
+	resource.ImportStatePassthroughID(ctx, path.Root("organization_id"), req, resp)
+	req.ID = parts[1]
+	resource.ImportStatePassthroughID(ctx, path.Root("team_member").AtName("id"), req, resp)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *teamMemberResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*statuspal.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf(
+				"Expected *statuspal.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func mapTeamMemberModelToRequestBody(member *teamMemberModel) *statuspal.TeamMember {
+	return &statuspal.TeamMember{
+		Email: member.Email.ValueString(),
+		Role:  member.Role.ValueString(),
+	}
+}
+
+func mapResponseToTeamMemberModel(member *statuspal.TeamMember) *teamMemberModel {
+	name := types.StringNull()
+	if member.Name != "" {
+		name = types.StringValue(member.Name)
+	}
+
+	return &teamMemberModel{
+		ID:                   types.StringValue(strconv.FormatInt(member.ID, 10)),
+		Email:                types.StringValue(member.Email),
+		Role:                 types.StringValue(member.Role),
+		Name:                 name,
+		State:                types.StringValue(member.State),
+		InvitationSentAt:     types.StringPointerValue(member.InvitationSentAt),
+		InvitationAcceptedAt: types.StringPointerValue(member.InvitationAcceptedAt),
+		InsertedAt:           types.StringValue(member.InsertedAt),
+		UpdatedAt:            types.StringValue(member.UpdatedAt),
+	}
+}
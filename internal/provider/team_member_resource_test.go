@@ -0,0 +1,152 @@
+package provider
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccTeamMemberResource(t *testing.T) {
+	responseBody := `{
+		"member": {
+			"id": 1,
+			"email": "jane@example.com",
+			"role": "member",
+			"state": "invited",
+			"invitation_sent_at": "2024-05-16T10:00:00",
+			"inserted_at": "2024-05-16T10:00:00",
+			"updated_at": "2024-05-16T10:00:00"
+		}
+	}`
+	// The invitation accepted by the user, who filled their name
+	acceptedResponseBody := `{
+		"member": {
+			"id": 1,
+			"email": "jane@example.com",
+			"role": "member",
+			"name": "Jane Doe",
+			"state": "active",
+			"invitation_sent_at": "2024-05-16T10:00:00",
+			"invitation_accepted_at": "2024-05-17T09:00:00",
+			"inserted_at": "2024-05-16T10:00:00",
+			"updated_at": "2024-05-17T09:00:00"
+		}
+	}`
+	updatedResponseBody := strings.Replace(acceptedResponseBody, `"role": "member"`, `"role": "admin"`, 1)
+
+	var accepted, updated atomic.Bool
+	var deleted atomic.Int32
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /orgs/1/members", func(w http.ResponseWriter, r *http.Request) {
+		if _, err := w.Write([]byte(responseBody)); err != nil {
+			log.Printf(`Error writing "/orgs/1/members" response: %v`, err)
+		}
+	})
+	mux.HandleFunc("/orgs/1/members/1", func(w http.ResponseWriter, r *http.Request) {
+		body := responseBody
+		if updated.Load() {
+			body = updatedResponseBody
+		} else if accepted.Load() {
+			body = acceptedResponseBody
+		}
+
+		switch r.Method {
+		case http.MethodPut:
+			updated.Store(true)
+			body = updatedResponseBody
+		case http.MethodDelete:
+			deleted.Add(1)
+			body = `""`
+		}
+
+		if _, err := w.Write([]byte(body)); err != nil {
+			log.Printf(`Error writing "/orgs/1/members/1" response with method "%s": %v`, r.Method, err)
+		}
+	})
+	mock := httptest.NewServer(mux)
+	defer mock.Close()
+
+	providerConfig := *providerConfig(&mock.URL)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Invalid role error testing
+			{
+				Config: providerConfig + `
+resource "statuspal_team_member" "test" {
+  organization_id = "1"
+  team_member = {
+    email = "jane@example.com"
+    role  = "owner"
+  }
+}
+`,
+				ExpectError: regexp.MustCompile(`Attribute team_member.role value must be one of`),
+			},
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "statuspal_team_member" "test" {
+  organization_id = "1"
+  team_member = {
+    email = "jane@example.com"
+    role  = "member"
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("statuspal_team_member.test", "organization_id", "1"),
+					resource.TestCheckResourceAttr("statuspal_team_member.test", "team_member.id", "1"),
+					resource.TestCheckResourceAttr("statuspal_team_member.test", "team_member.email", "jane@example.com"),
+					resource.TestCheckResourceAttr("statuspal_team_member.test", "team_member.role", "member"),
+					resource.TestCheckResourceAttr("statuspal_team_member.test", "team_member.state", "invited"),
+					resource.TestCheckResourceAttr("statuspal_team_member.test", "team_member.invitation_sent_at", "2024-05-16T10:00:00"),
+					resource.TestCheckNoResourceAttr("statuspal_team_member.test", "team_member.invitation_accepted_at"),
+					resource.TestCheckNoResourceAttr("statuspal_team_member.test", "team_member.name"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "statuspal_team_member.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     "1 1",
+			},
+			// Accepted invitation and Update testing
+			{
+				PreConfig: func() { accepted.Store(true) },
+				Config: providerConfig + `
+resource "statuspal_team_member" "test" {
+  organization_id = "1"
+  team_member = {
+    email = "jane@example.com"
+    role  = "admin"
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("statuspal_team_member.test", "team_member.id", "1"),
+					resource.TestCheckResourceAttr("statuspal_team_member.test", "team_member.role", "admin"),
+					resource.TestCheckResourceAttr("statuspal_team_member.test", "team_member.name", "Jane Doe"),
+					resource.TestCheckResourceAttr("statuspal_team_member.test", "team_member.state", "active"),
+					resource.TestCheckResourceAttr("statuspal_team_member.test", "team_member.invitation_accepted_at", "2024-05-17T09:00:00"),
+				),
+			},
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			if deleted.Load() != 1 {
+				return fmt.Errorf("expected the team member to be removed")
+			}
+			return nil
+		},
+	})
+}
@@ -0,0 +1,187 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	statuspal "terraform-provider-statuspal/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &teamMembersDataSource{}
+	_ datasource.DataSourceWithConfigure = &teamMembersDataSource{}
+)
+
+// NewTeamMembersDataSource is a helper function to simplify the provider implementation.
+func NewTeamMembersDataSource() datasource.DataSource {
+	return &teamMembersDataSource{}
+}
+
+// teamMembersDataSource is the data source implementation.
+type teamMembersDataSource struct {
+	client *statuspal.Client
+}
+
+// teamMembersDataSourceModel maps the data source schema data.
+type teamMembersDataSourceModel struct {
+	ID             types.String      `tfsdk:"id"` // only for test case
+	OrganizationID types.String      `tfsdk:"organization_id"`
+	Limit          types.Int64       `tfsdk:"limit"`
+	TeamMembers    []teamMemberModel `tfsdk:"team_members"`
+}
+
+// Metadata returns the data source type name.
+func (d *teamMembersDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_team_members"
+}
+
+// Schema defines the schema for the data source.
+func (d *teamMembersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the list of members of the organization, including the pending invitations.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier attribute. Ignore it, only used in testing.",
+				Computed:    true,
+			},
+			"organization_id": schema.StringAttribute{
+				Description: "The organization ID of the team members.",
+				Required:    true,
+			},
+			"limit": schema.Int64Attribute{
+				Description: "The maximum number of team members to return. By default, all the team members of the organization are returned.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"team_members": schema.ListNestedAttribute{
+				Description: "List of team members.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the team member.",
+							Computed:    true,
+						},
+						"email": schema.StringAttribute{
+							Description: "The email address of the team member.",
+							Computed:    true,
+						},
+						"role": schema.StringAttribute{
+							Description: "The role of the team member in the organization.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the user, once the invitation is accepted.",
+							Computed:    true,
+						},
+						"state": schema.StringAttribute{
+							MarkdownDescription: "The state of the membership: `invited` until the user accepts the invitation, then `active`.",
+							Computed:            true,
+						},
+						"invitation_sent_at": schema.StringAttribute{
+							Description: "Datetime at which the invitation was sent.",
+							Computed:    true,
+						},
+						"invitation_accepted_at": schema.StringAttribute{
+							Description: "Datetime at which the invitation was accepted.",
+							Computed:    true,
+						},
+						"inserted_at": schema.StringAttribute{
+							Description: "Datetime at which the team member was inserted.",
+							Computed:    true,
+						},
+						"updated_at": schema.StringAttribute{
+							Description: "Datetime at which the team member was last updated.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *teamMembersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Retrieve values from config
+	var state teamMembersDataSourceModel
+	diagnostics := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diagnostics...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organizationID := state.OrganizationID.ValueString()
+	limit := state.Limit.ValueInt64()
+	members := []statuspal.TeamMember{}
+	err := d.client.ListTeamMembers(ctx, &organizationID, func(page []statuspal.TeamMember) bool {
+		members = append(members, page...)
+		return limit == 0 || int64(len(members)) < limit
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read StatusPal Team Members",
+			err.Error(),
+		)
+		return
+	}
+
+	if limit > 0 && int64(len(members)) > limit {
+		members = members[:limit]
+	}
+
+	// Map response body to model
+	state.TeamMembers = make([]teamMemberModel, 0, len(members))
+	for i := range members {
+		state.TeamMembers = append(state.TeamMembers, *mapResponseToTeamMemberModel(&members[i]))
+	}
+	state.ID = types.StringValue("placeholder") // only for test case
+
+	// Set state
+	diagnostics = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diagnostics...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *teamMembersDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*statuspal.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf(
+				"Expected *statuspal.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	d.client = client
+}
@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTeamMembersDataSource(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /orgs/1/members", func(w http.ResponseWriter, r *http.Request) {
+		if _, err := w.Write([]byte(`{
+			"members": [
+				{
+					"id": 1,
+					"email": "jane@example.com",
+					"role": "admin",
+					"state": "invited",
+					"invitation_sent_at": "2024-05-16T10:00:00",
+					"inserted_at": "2024-05-16T10:00:00",
+					"updated_at": "2024-05-16T10:00:00"
+				},
+				{
+					"id": 2,
+					"email": "john@example.com",
+					"role": "member",
+					"name": "John Doe",
+					"state": "active",
+					"invitation_sent_at": "2024-05-16T10:00:00",
+					"invitation_accepted_at": "2024-05-17T09:00:00",
+					"inserted_at": "2024-05-16T10:00:00",
+					"updated_at": "2024-05-17T09:00:00"
+				}
+			],
+			"links": {"prev": null, "next": null}
+		}`)); err != nil {
+			log.Printf(`Error writing "/orgs/1/members" response: %v`, err)
+		}
+	})
+	mock := httptest.NewServer(mux)
+	defer mock.Close()
+
+	providerConfig := *providerConfig(&mock.URL)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `
+data "statuspal_team_members" "test" {
+  organization_id = "1"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.statuspal_team_members.test", "team_members.#", "2"),
+					resource.TestCheckResourceAttr("data.statuspal_team_members.test", "team_members.0.email", "jane@example.com"),
+					resource.TestCheckResourceAttr("data.statuspal_team_members.test", "team_members.0.role", "admin"),
+					resource.TestCheckResourceAttr("data.statuspal_team_members.test", "team_members.0.state", "invited"),
+					resource.TestCheckResourceAttr("data.statuspal_team_members.test", "team_members.1.email", "john@example.com"),
+					resource.TestCheckResourceAttr("data.statuspal_team_members.test", "team_members.1.role", "member"),
+					resource.TestCheckResourceAttr("data.statuspal_team_members.test", "team_members.1.name", "John Doe"),
+					resource.TestCheckResourceAttr("data.statuspal_team_members.test", "team_members.1.state", "active"),
+				),
+			},
+		},
+	})
+}
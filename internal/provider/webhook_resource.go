@@ -0,0 +1,463 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	statuspal "terraform-provider-statuspal/internal/client"
+)
+
+// webhookEvents are the events of the status page which can be posted to a webhook.
+var webhookEvents = []string{
+	"incident.created",
+	"incident.updated",
+	"incident.resolved",
+	"maintenance.created",
+	"maintenance.updated",
+	"maintenance.completed",
+	"service.status_changed",
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &webhookResource{}
+	_ resource.ResourceWithConfigure      = &webhookResource{}
+	_ resource.ResourceWithImportState    = &webhookResource{}
+	_ resource.ResourceWithValidateConfig = &webhookResource{}
+)
+
+// NewWebhookResource is a helper function to simplify the provider implementation.
+func NewWebhookResource() resource.Resource {
+	return &webhookResource{}
+}
+
+// webhookResource is the resource implementation.
+type webhookResource struct {
+	client *statuspal.Client
+}
+
+// webhookResourceModel maps the resource schema data.
+type webhookResourceModel struct {
+	ID                  types.String `tfsdk:"id"` // only for test case
+	StatusPageSubdomain types.String `tfsdk:"status_page_subdomain"`
+	Webhook             webhookModel `tfsdk:"webhook"`
+}
+
+// webhookModel maps webhook schema data.
+type webhookModel struct {
+	ID            types.String `tfsdk:"id"`
+	Url           types.String `tfsdk:"url"`
+	Secret        types.String `tfsdk:"secret"`
+	SecretVersion types.Int64  `tfsdk:"secret_version"`
+	Events        types.Set    `tfsdk:"events"`
+	ServiceIDs    types.List   `tfsdk:"service_ids"`
+	InsertedAt    types.String `tfsdk:"inserted_at"`
+	UpdatedAt     types.String `tfsdk:"updated_at"`
+}
+
+// Metadata returns the resource type name.
+func (r *webhookResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_webhook"
+}
+
+// Schema defines the schema for the resource.
+func (r *webhookResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an outgoing webhook notified about the events of the status page.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier attribute. Ignore it, only used in testing.",
+				Computed:    true,
+			},
+			"status_page_subdomain": schema.StringAttribute{
+				Description: "The status page's subdomain where the webhook belong.",
+				Required:    true,
+			},
+			"webhook": schema.SingleNestedAttribute{
+				Description: "The webhook.",
+				Required:    true,
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Description: "The ID of the webhook.",
+						Computed:    true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"url": schema.StringAttribute{
+						Description: "The HTTP(S) URL the events are posted to.",
+						Required:    true,
+					},
+					"secret": schema.StringAttribute{
+						MarkdownDescription: "The secret used to sign the payloads posted to the URL. It is write-only: it is only sent to StatusPal, which never returns it, " +
+							"and it is not stored in the Terraform state. It is sent when the webhook is created and when `secret_version` changes. Requires Terraform 1.11 or later.",
+						Optional:  true,
+						Sensitive: true,
+						WriteOnly: true,
+					},
+					"secret_version": schema.Int64Attribute{
+						MarkdownDescription: "The version of the `secret`. Change it to send the `secret` to StatusPal again, e.g. after rotating it, " +
+							"or to clear the secret of the webhook once the `secret` is removed.",
+						Optional: true,
+					},
+					"events": schema.SetAttribute{
+						MarkdownDescription: "The events posted to the URL, all the events when empty. Enum: " + webhookEventsDescription() + ".",
+						Optional:            true,
+						Computed:            true,
+						ElementType:         types.StringType,
+						Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+						Validators: []validator.Set{
+							setvalidator.ValueStringsAre(stringvalidator.OneOf(webhookEvents...)),
+						},
+					},
+					"service_ids": schema.ListAttribute{
+						Description: "IDs of the services the events are posted about, all the services when empty.",
+						Optional:    true,
+						Computed:    true,
+						ElementType: types.StringType,
+						Default:     listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{})),
+					},
+					"inserted_at": schema.StringAttribute{
+						Description: "Datetime at which the webhook was inserted.",
+						Computed:    true,
+					},
+					"updated_at": schema.StringAttribute{
+						Description: "Datetime at which the webhook was last updated.",
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig checks the URL of the webhook.
+func (r *webhookResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var webhookURL types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("webhook").AtName("url"), &webhookURL)...)
+	if resp.Diagnostics.HasError() || webhookURL.IsNull() || webhookURL.IsUnknown() {
+		return
+	}
+
+	parsedURL, err := url.Parse(webhookURL.ValueString())
+	if err != nil || (parsedURL.Scheme != "http" && parsedURL.Scheme != "https") || parsedURL.Host == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("webhook").AtName("url"),
+			"Invalid StatusPal Webhook URL",
+			fmt.Sprintf("The URL %q of the webhook must be an http or https URL.", webhookURL.ValueString()),
+		)
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *webhookResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan webhookResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	webhook := mapWebhookModelToRequestBody(ctx, &plan.Webhook, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The secret is write-only, it is only available in the configuration
+	var secret types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("webhook").AtName("secret"), &secret)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	webhook.Secret = secret.ValueStringPointer()
+
+	// Create new webhook
+	statusPageSubdomain := plan.StatusPageSubdomain.ValueString()
+	newWebhook, err := r.client.CreateWebhook(ctx, webhook, &statusPageSubdomain)
+	if err != nil {
+		addClientError(&resp.Diagnostics, path.Root("webhook"),
+			"Error creating StatusPal Webhook",
+			"Could not create webhook, unexpected error: ",
+			err,
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	webhookModel := mapResponseToWebhookModel(ctx, newWebhook, &plan.Webhook, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.Webhook = *webhookModel
+	plan.ID = types.StringValue("placeholder") // only for test case
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *webhookResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state webhookResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed webhook value from StatusPal
+	statusPageSubdomain := state.StatusPageSubdomain.ValueString()
+	webhookID := state.Webhook.ID.ValueString()
+	webhook, err := r.client.GetWebhook(ctx, &statusPageSubdomain, &webhookID)
+	if statuspal.ErrorNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading StatusPal Webhook",
+			"Could not read webhook ID "+webhookID+": "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	webhookModel := mapResponseToWebhookModel(ctx, webhook, &state.Webhook, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Webhook = *webhookModel
+	state.ID = types.StringValue("placeholder") // only for test case
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *webhookResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan webhookResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	webhook := mapWebhookModelToRequestBody(ctx, &plan.Webhook, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The API keeps the secret when it is omitted, so the secret of the configuration is only sent when its
+	// version changes, and a removed secret is cleared explicitly
+	var state webhookResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !plan.Webhook.SecretVersion.Equal(state.Webhook.SecretVersion) {
+		var secret types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("webhook").AtName("secret"), &secret)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		webhook.Secret = new(string)
+		*webhook.Secret = secret.ValueString()
+	}
+
+	// Update existing webhook
+	statusPageSubdomain := plan.StatusPageSubdomain.ValueString()
+	webhookID := plan.Webhook.ID.ValueString()
+	updatedWebhook, err := r.client.UpdateWebhook(ctx, webhook, &statusPageSubdomain, &webhookID)
+	if err != nil {
+		addClientError(&resp.Diagnostics, path.Root("webhook"),
+			"Error Updating StatusPal Webhook",
+			"Could not Update webhook, unexpected error: ",
+			err,
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	webhookModel := mapResponseToWebhookModel(ctx, updatedWebhook, &plan.Webhook, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.Webhook = *webhookModel
+	plan.ID = types.StringValue("placeholder") // only for test case
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *webhookResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state webhookResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing webhook
+	statusPageSubdomain := state.StatusPageSubdomain.ValueString()
+	webhookID := state.Webhook.ID.ValueString()
+	err := r.client.DeleteWebhook(ctx, &statusPageSubdomain, &webhookID)
+	if err != nil && !statuspal.ErrorNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting StatusPal Webhook",
+			"Could not delete webhook, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *webhookResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	// Split the ID based on the delimiter used during import
+	parts := strings.Split(req.ID, " ")
+	if len(parts) != 2 {
+		resp.Diagnostics.AddError(
+			"Unexpected StatusPal Webhook Import Identifier",
+			`Expected StatusPal webhook import identifier with format: "<status_page_subdomain> <webhook_id>"`,
+		)
+		return
+	}
+
+	req.ID = parts[0]
+	resource.ImportStatePassthroughID(ctx, path.Root("status_page_subdomain"), req, resp)
+	req.ID = parts[1]
+	resource.ImportStatePassthroughID(ctx, path.Root("webhook").AtName("id"), req, resp)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *webhookResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*statuspal.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf(
+				"Expected *statuspal.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// webhookEventsDescription returns the markdown list of the webhook events.
+func webhookEventsDescription() string {
+	events := make([]string, 0, len(webhookEvents))
+	for _, event := range webhookEvents {
+		events = append(events, "`\""+event+"\"`")
+	}
+
+	return strings.Join(events, " ")
+}
+
+func mapWebhookModelToRequestBody(
+	ctx context.Context,
+	webhook *webhookModel,
+	diagnostics *diag.Diagnostics,
+) *statuspal.Webhook {
+	serviceIDs := parseServiceIDs(ctx, webhook.ServiceIDs, diagnostics)
+	if diagnostics.HasError() {
+		return nil
+	}
+
+	events := []string{}
+	if !webhook.Events.IsNull() && !webhook.Events.IsUnknown() {
+		diagnostics.Append(webhook.Events.ElementsAs(ctx, &events, false)...)
+		if diagnostics.HasError() {
+			return nil
+		}
+	}
+	sort.Strings(events)
+
+	return &statuspal.Webhook{
+		Url:        webhook.Url.ValueString(),
+		Events:     events,
+		ServiceIDs: serviceIDs,
+	}
+}
+
+func mapResponseToWebhookModel(
+	ctx context.Context,
+	webhook *statuspal.Webhook,
+	previous *webhookModel,
+	diagnostics *diag.Diagnostics,
+) *webhookModel {
+	serviceIDs := mapServiceIDsToList(ctx, previous.ServiceIDs, webhook.ServiceIDs, diagnostics)
+	if diagnostics.HasError() {
+		return nil
+	}
+
+	events, diags := types.SetValueFrom(ctx, types.StringType, append([]string{}, webhook.Events...))
+	diagnostics.Append(diags...)
+	if diagnostics.HasError() {
+		return nil
+	}
+
+	return &webhookModel{
+		ID:  types.StringValue(strconv.FormatInt(webhook.ID, 10)),
+		Url: types.StringValue(webhook.Url),
+		// The secret is write-only, it is never stored in the state
+		Secret:        types.StringNull(),
+		SecretVersion: previous.SecretVersion,
+		Events:        events,
+		ServiceIDs:    serviceIDs,
+		InsertedAt:    types.StringValue(webhook.InsertedAt),
+		UpdatedAt:     types.StringValue(webhook.UpdatedAt),
+	}
+}
@@ -0,0 +1,243 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	statuspal "terraform-provider-statuspal/internal/client"
+)
+
+func TestAccWebhookResource(t *testing.T) {
+	// The secret is never returned by the API
+	responseBody := `{
+		"webhook": {
+			"id": 1,
+			"url": "https://example.com/hooks",
+			"events": [],
+			"service_ids": [],
+			"inserted_at": "2024-05-16T10:00:00",
+			"updated_at": "2024-05-16T10:00:00"
+		}
+	}`
+	updatedResponseBody := `{
+		"webhook": {
+			"id": 1,
+			"url": "https://example.com/statuspal",
+			"events": ["incident.created", "incident.resolved"],
+			"service_ids": [2, 1],
+			"inserted_at": "2024-05-16T10:00:00",
+			"updated_at": "2024-05-16T11:00:00"
+		}
+	}`
+
+	// The secrets sent to StatusPal, nil when omitted
+	var secrets []*string
+	var updated atomic.Bool
+	var deleted atomic.Int32
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /status_pages/example-com/webhooks", func(w http.ResponseWriter, r *http.Request) {
+		var request statuspal.WebhookResponse
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			http.Error(w, fmt.Sprintf("Failed to decode JSON: %v", err), http.StatusBadRequest)
+			return
+		}
+		secrets = append(secrets, request.Webhook.Secret)
+
+		if _, err := w.Write([]byte(responseBody)); err != nil {
+			log.Printf(`Error writing "/status_pages/example-com/webhooks" response: %v`, err)
+		}
+	})
+	mux.HandleFunc("/status_pages/example-com/webhooks/1", func(w http.ResponseWriter, r *http.Request) {
+		body := responseBody
+		if updated.Load() {
+			body = updatedResponseBody
+		}
+
+		switch r.Method {
+		case http.MethodPut:
+			var request statuspal.WebhookResponse
+			if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+				http.Error(w, fmt.Sprintf("Failed to decode JSON: %v", err), http.StatusBadRequest)
+				return
+			}
+			secrets = append(secrets, request.Webhook.Secret)
+			updated.Store(true)
+			body = updatedResponseBody
+		case http.MethodDelete:
+			deleted.Add(1)
+			body = `""`
+		}
+
+		if _, err := w.Write([]byte(body)); err != nil {
+			log.Printf(`Error writing "/status_pages/example-com/webhooks/1" response with method "%s": %v`, r.Method, err)
+		}
+	})
+	mock := httptest.NewServer(mux)
+	defer mock.Close()
+
+	providerConfig := *providerConfig(&mock.URL)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Invalid URL error testing
+			{
+				Config: providerConfig + `
+resource "statuspal_webhook" "test" {
+  status_page_subdomain = "example-com"
+  webhook = {
+    url = "example.com/hooks"
+  }
+}
+`,
+				ExpectError: regexp.MustCompile(`must be an http or https URL`),
+			},
+			// Invalid event error testing
+			{
+				Config: providerConfig + `
+resource "statuspal_webhook" "test" {
+  status_page_subdomain = "example-com"
+  webhook = {
+    url    = "https://example.com/hooks"
+    events = ["incident.deleted"]
+  }
+}
+`,
+				ExpectError: regexp.MustCompile(`value must be one of`),
+			},
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "statuspal_webhook" "test" {
+  status_page_subdomain = "example-com"
+  webhook = {
+    url            = "https://example.com/hooks"
+    secret         = "s3cr3t"
+    secret_version = 1
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("statuspal_webhook.test", "status_page_subdomain", "example-com"),
+					resource.TestCheckResourceAttr("statuspal_webhook.test", "webhook.id", "1"),
+					resource.TestCheckResourceAttr("statuspal_webhook.test", "webhook.url", "https://example.com/hooks"),
+					resource.TestCheckNoResourceAttr("statuspal_webhook.test", "webhook.secret"),
+					resource.TestCheckResourceAttr("statuspal_webhook.test", "webhook.secret_version", "1"),
+					resource.TestCheckResourceAttr("statuspal_webhook.test", "webhook.events.#", "0"),
+					resource.TestCheckResourceAttr("statuspal_webhook.test", "webhook.service_ids.#", "0"),
+					resource.TestCheckResourceAttr("statuspal_webhook.test", "webhook.inserted_at", "2024-05-16T10:00:00"),
+					func(_ *terraform.State) error {
+						if len(secrets) != 1 || secrets[0] == nil || *secrets[0] != "s3cr3t" {
+							return fmt.Errorf("expected the secret to be sent to StatusPal, got: %v", secrets)
+						}
+						return nil
+					},
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "statuspal_webhook.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateId:           "example-com 1",
+				ImportStateVerifyIgnore: []string{"webhook.secret_version"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "statuspal_webhook" "test" {
+  status_page_subdomain = "example-com"
+  webhook = {
+    url            = "https://example.com/statuspal"
+    secret         = "s3cr3t"
+    secret_version = 1
+    events         = ["incident.created", "incident.resolved"]
+    service_ids    = ["2", "1"]
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("statuspal_webhook.test", "webhook.id", "1"),
+					resource.TestCheckResourceAttr("statuspal_webhook.test", "webhook.url", "https://example.com/statuspal"),
+					resource.TestCheckResourceAttr("statuspal_webhook.test", "webhook.events.#", "2"),
+					resource.TestCheckTypeSetElemAttr("statuspal_webhook.test", "webhook.events.*", "incident.created"),
+					resource.TestCheckTypeSetElemAttr("statuspal_webhook.test", "webhook.events.*", "incident.resolved"),
+					resource.TestCheckResourceAttr("statuspal_webhook.test", "webhook.service_ids.#", "2"),
+					resource.TestCheckResourceAttr("statuspal_webhook.test", "webhook.service_ids.0", "2"),
+					resource.TestCheckResourceAttr("statuspal_webhook.test", "webhook.service_ids.1", "1"),
+					resource.TestCheckResourceAttr("statuspal_webhook.test", "webhook.updated_at", "2024-05-16T11:00:00"),
+					resource.TestCheckNoResourceAttr("statuspal_webhook.test", "webhook.secret"),
+					func(_ *terraform.State) error {
+						if last := secrets[len(secrets)-1]; last != nil {
+							return fmt.Errorf("expected the unchanged secret not to be sent, got: %v", *last)
+						}
+						return nil
+					},
+				),
+			},
+			// Secret rotation testing
+			{
+				Config: providerConfig + `
+resource "statuspal_webhook" "test" {
+  status_page_subdomain = "example-com"
+  webhook = {
+    url            = "https://example.com/statuspal"
+    secret         = "n3w-s3cr3t"
+    secret_version = 2
+    events         = ["incident.created", "incident.resolved"]
+    service_ids    = ["2", "1"]
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("statuspal_webhook.test", "webhook.secret"),
+					resource.TestCheckResourceAttr("statuspal_webhook.test", "webhook.secret_version", "2"),
+					func(_ *terraform.State) error {
+						if last := secrets[len(secrets)-1]; last == nil || *last != "n3w-s3cr3t" {
+							return fmt.Errorf("expected the rotated secret to be sent to StatusPal, got: %v", last)
+						}
+						return nil
+					},
+				),
+			},
+			// Secret removal testing
+			{
+				Config: providerConfig + `
+resource "statuspal_webhook" "test" {
+  status_page_subdomain = "example-com"
+  webhook = {
+    url            = "https://example.com/statuspal"
+    secret_version = 3
+    events         = ["incident.created", "incident.resolved"]
+    service_ids    = ["2", "1"]
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("statuspal_webhook.test", "webhook.secret"),
+					func(_ *terraform.State) error {
+						if last := secrets[len(secrets)-1]; last == nil || *last != "" {
+							return fmt.Errorf("expected the secret to be cleared, got: %v", last)
+						}
+						return nil
+					},
+				),
+			},
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			if deleted.Load() != 1 {
+				return fmt.Errorf("expected the webhook to be deleted")
+			}
+			return nil
+		},
+	})
+}